@@ -1,6 +1,7 @@
 package gonumberio
 
 import (
+	"context"
 	"errors"
 	"io"
 
@@ -9,12 +10,36 @@ import (
 
 const (
 	// Default buffer size for ByteReader
-	DefaultChunkSize int = 32768
+	DefaultChunkSize int = ite.DefaultChunkSize
 )
 
 // Exported ByteReader
 type ByteReader = ite.ByteReader
 
+// Exported Options
+type Options = ite.Options
+
+// Exported Token, element type of whitespace separated tokens as bytes
+// for Read and other functions that count slices as dimensions
+type Token = ite.Token
+
+// Exported PositionError, returned with line and offset of a conversion error
+type PositionError = ite.PositionError
+
+// Constructs new ByteReader that keeps its position across several
+// calls of functions with From suffix
+func NewByteReader(r io.Reader, chunkSize int) *ByteReader {
+	return ite.NewByteReader(r, chunkSize)
+}
+
+// Constructs new ByteReader that reads the next chunk of r in a background
+// goroutine while the current chunk is being parsed.
+// The goroutine stops at the end of input, after an error of r
+// or when ctx is done. Cancel ctx to stop it before the end of input.
+func NewPrefetchByteReader(ctx context.Context, r io.Reader, chunkSize int) *ByteReader {
+	return ite.NewPrefetchByteReader(ctx, r, chunkSize)
+}
+
 // Read one element of type T from a Reader
 func Read0D[T any](r io.Reader) (T, error) {
 	return Read0DCustom(r, DefaultChunkSize, GetConversion[T]())
@@ -24,7 +49,14 @@ func Read0D[T any](r io.Reader) (T, error) {
 func Read0DCustom[T any](
 	r io.Reader, chunkSize int, conv func(*ByteReader) (T, uint, error)) (T, error) {
 
-	arr, err := Read1DCustom[T](r, chunkSize, conv)
+	return Read0DOptions(r, Options{ChunkSize: chunkSize}, conv)
+}
+
+// Read one element of type T from a Reader with options
+func Read0DOptions[T any](
+	r io.Reader, opts Options, conv func(*ByteReader) (T, uint, error)) (T, error) {
+
+	arr, err := Read1DOptions[T](r, opts, conv)
 
 	if err != nil {
 		var res T
@@ -39,6 +71,15 @@ func Read0DCustom[T any](
 	return arr[0], nil
 }
 
+// Read a 1D slice of type T from a ByteReader with options.
+// Bytes after the limit or the sentinel line stay unread in the ByteReader.
+func Read1DFrom[T any](
+	r *ByteReader, opts Options, conv func(*ByteReader) (T, uint, error)) ([]T, error) {
+
+	reader, err := ite.RunSliceReaderFrom(r, opts, conv, 1, ite.Spare[T]{})
+	return reader.Buf1, err
+}
+
 // Read a 1D slice of type T from a Reader
 func Read1D[T any](r io.Reader) ([]T, error) {
 	return Read1DCustom(r, DefaultChunkSize, GetConversion[T]())
@@ -48,10 +89,26 @@ func Read1D[T any](r io.Reader) ([]T, error) {
 func Read1DCustom[T any](
 	r io.Reader, chunkSize int, conv func(*ByteReader) (T, uint, error)) ([]T, error) {
 
-	reader, err := ite.RunSliceReader(r, chunkSize, conv, 1)
+	return Read1DOptions(r, Options{ChunkSize: chunkSize}, conv)
+}
+
+// Read a 1D slice of type T from a Reader with options
+func Read1DOptions[T any](
+	r io.Reader, opts Options, conv func(*ByteReader) (T, uint, error)) ([]T, error) {
+
+	reader, err := ite.RunSliceReader(r, opts, conv, 1, ite.Spare[T]{})
 	return reader.Buf1, err
 }
 
+// Read a 2D slice of type T from a ByteReader with options.
+// Bytes after the limit or the sentinel line stay unread in the ByteReader.
+func Read2DFrom[T any](
+	r *ByteReader, opts Options, conv func(*ByteReader) (T, uint, error)) ([][]T, error) {
+
+	reader, err := ite.RunSliceReaderFrom(r, opts, conv, 2, ite.Spare[T]{})
+	return reader.Buf2, err
+}
+
 // Read a 2D slice of type T from a Reader
 func Read2D[T any](r io.Reader) ([][]T, error) {
 	return Read2DCustom(r, DefaultChunkSize, GetConversion[T]())
@@ -61,10 +118,26 @@ func Read2D[T any](r io.Reader) ([][]T, error) {
 func Read2DCustom[T any](
 	r io.Reader, chunkSize int, conv func(*ByteReader) (T, uint, error)) ([][]T, error) {
 
-	reader, err := ite.RunSliceReader(r, chunkSize, conv, 2)
+	return Read2DOptions(r, Options{ChunkSize: chunkSize}, conv)
+}
+
+// Read a 2D slice of type T from a Reader with options
+func Read2DOptions[T any](
+	r io.Reader, opts Options, conv func(*ByteReader) (T, uint, error)) ([][]T, error) {
+
+	reader, err := ite.RunSliceReader(r, opts, conv, 2, ite.Spare[T]{})
 	return reader.Buf2, err
 }
 
+// Read a 3D slice of type T from a ByteReader with options.
+// Bytes after the limit or the sentinel line stay unread in the ByteReader.
+func Read3DFrom[T any](
+	r *ByteReader, opts Options, conv func(*ByteReader) (T, uint, error)) ([][][]T, error) {
+
+	reader, err := ite.RunSliceReaderFrom(r, opts, conv, 3, ite.Spare[T]{})
+	return reader.Buf3, err
+}
+
 // Read a 3D slice of type T from a Reader
 func Read3D[T any](r io.Reader) ([][][]T, error) {
 	return Read3DCustom(r, DefaultChunkSize, GetConversion[T]())
@@ -74,6 +147,13 @@ func Read3D[T any](r io.Reader) ([][][]T, error) {
 func Read3DCustom[T any](
 	r io.Reader, chunkSize int, conv func(*ByteReader) (T, uint, error)) ([][][]T, error) {
 
-	reader, err := ite.RunSliceReader(r, chunkSize, conv, 3)
+	return Read3DOptions(r, Options{ChunkSize: chunkSize}, conv)
+}
+
+// Read a 3D slice of type T from a Reader with options
+func Read3DOptions[T any](
+	r io.Reader, opts Options, conv func(*ByteReader) (T, uint, error)) ([][][]T, error) {
+
+	reader, err := ite.RunSliceReader(r, opts, conv, 3, ite.Spare[T]{})
 	return reader.Buf3, err
 }
@@ -0,0 +1,157 @@
+package gonumberio_test
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	nio "github.com/Matej-Chmel/go-number-io"
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+// Returns rows of cols numbers formatted by format
+func benchInput(rows, cols int, format func(int) string) []byte {
+	var builder strings.Builder
+
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			if j > 0 {
+				builder.WriteByte(' ')
+			}
+
+			builder.WriteString(format(i*cols + j))
+		}
+
+		builder.WriteByte('\n')
+	}
+
+	return []byte(builder.String())
+}
+
+// Runs read for each iteration and reports throughput of input
+func runBench[T any](b *testing.B, input []byte, read func([]byte) ([][]T, error)) {
+	b.SetBytes(int64(len(input)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := read(input); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// Hand-written loop over lines and fields with strconv
+func readStrconv[T any](input []byte, parse func(string) (T, error)) ([][]T, error) {
+	res := make([][]T, 0)
+
+	for _, line := range bytes.Split(input, []byte{'\n'}) {
+		fields := bytes.Fields(line)
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		row := make([]T, 0, len(fields))
+
+		for _, field := range fields {
+			val, err := parse(string(field))
+
+			if err != nil {
+				return nil, err
+			}
+
+			row = append(row, val)
+		}
+
+		res = append(res, row)
+	}
+
+	return res, nil
+}
+
+func intInput() []byte {
+	return benchInput(1000, 100, func(i int) string { return strconv.Itoa(i*7919%100003 - 50000) })
+}
+
+func floatInput() []byte {
+	return benchInput(1000, 100, func(i int) string {
+		return fmt.Sprintf("%.3f", float64(i*7919%100003)/7+1)
+	})
+}
+
+func BenchmarkReadInt(b *testing.B) {
+	runBench(b, intInput(), func(input []byte) ([][]int, error) {
+		return nio.Read2D[int](bytes.NewReader(input))
+	})
+}
+
+func BenchmarkReadIntBytes(b *testing.B) {
+	runBench(b, intInput(), nio.ReadBytes[[][]int])
+}
+
+func BenchmarkReadIntTemplate(b *testing.B) {
+	conv := func(r *nio.ByteReader) (int, uint, error) {
+		return ite.ConvertSignedTemplate(r, ite.ProcessIntNonDigit, ite.ProcessDigit[int])
+	}
+
+	runBench(b, intInput(), func(input []byte) ([][]int, error) {
+		return nio.Read2DCustom(bytes.NewReader(input), nio.DefaultChunkSize, conv)
+	})
+}
+
+func BenchmarkReadIntStrconv(b *testing.B) {
+	runBench(b, intInput(), func(input []byte) ([][]int, error) {
+		return readStrconv(input, strconv.Atoi)
+	})
+}
+
+func BenchmarkReadFloat(b *testing.B) {
+	runBench(b, floatInput(), func(input []byte) ([][]float64, error) {
+		return nio.Read2D[float64](bytes.NewReader(input))
+	})
+}
+
+func BenchmarkReadFloatStrconv(b *testing.B) {
+	runBench(b, floatInput(), func(input []byte) ([][]float64, error) {
+		return readStrconv(input, func(s string) (float64, error) {
+			return strconv.ParseFloat(s, 64)
+		})
+	})
+}
+
+func longRowsInput() []byte {
+	return benchInput(20, 20000, func(i int) string { return strconv.Itoa(i % 1000) })
+}
+
+func BenchmarkReadLongRows(b *testing.B) {
+	runBench(b, longRowsInput(), func(input []byte) ([][]int, error) {
+		return nio.Read2D[int](bytes.NewReader(input))
+	})
+}
+
+func BenchmarkReadLongRowsHint(b *testing.B) {
+	opts := nio.Options{ColsHint: 20000, RowsHint: 20}
+
+	runBench(b, longRowsInput(), func(input []byte) ([][]int, error) {
+		return nio.Read2DOptions(bytes.NewReader(input), opts, nio.GetConversion[int]())
+	})
+}
+
+func BenchmarkReadLongRowsPreallocate(b *testing.B) {
+	opts := nio.Options{Preallocate: true}
+
+	runBench(b, longRowsInput(), func(input []byte) ([][]int, error) {
+		return nio.Read2DOptions(bytes.NewReader(input), opts, nio.GetConversion[int]())
+	})
+}
+
+func BenchmarkReadLongRowsPreallocateBytes(b *testing.B) {
+	opts := nio.Options{Preallocate: true}
+
+	runBench(b, longRowsInput(), func(input []byte) ([][]int, error) {
+		return nio.ReadBytesOptions[[][]int](input, opts)
+	})
+}
@@ -0,0 +1,97 @@
+package gonumberio
+
+import (
+	"errors"
+	"math/big"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+const (
+	// Default precision in bits of ConvertBigFloat
+	DefaultBigFloatPrecision uint = 256
+)
+
+// Returns 10^exp as big.Int
+func bigPow10(exp uint) *big.Int {
+	ten := big.NewInt(10)
+	return ten.Exp(ten, big.NewInt(int64(exp)), nil)
+}
+
+// Conversion function for type *big.Float with default precision
+func ConvertBigFloat(r *ByteReader) (*big.Float, uint, error) {
+	return convertBigFloat(r, DefaultBigFloatPrecision)
+}
+
+// Conversion function for type *big.Int
+func ConvertBigInt(r *ByteReader) (*big.Int, uint, error) {
+	parts, flags, err := ite.ConvertBigTemplate(r, false, false)
+
+	if parts.Mantissa == nil {
+		return nil, flags, err
+	}
+
+	if parts.Negative {
+		parts.Mantissa.Neg(parts.Mantissa)
+	}
+
+	return parts.Mantissa, flags, err
+}
+
+// Conversion function for type *big.Rat.
+// Accepts decimal numbers like "-3.14" and fractions like "22/7".
+func ConvertBigRat(r *ByteReader) (*big.Rat, uint, error) {
+	parts, flags, err := ite.ConvertBigTemplate(r, true, true)
+
+	if parts.Mantissa == nil {
+		return nil, flags, err
+	}
+
+	denominator := bigPow10(parts.Exponent)
+
+	if parts.Denominator != nil {
+		if parts.Denominator.Sign() == 0 {
+			return nil, 0, errors.New("Zero denominator")
+		}
+
+		denominator.Mul(denominator, parts.Denominator)
+	}
+
+	if parts.Negative {
+		parts.Mantissa.Neg(parts.Mantissa)
+	}
+
+	return new(big.Rat).SetFrac(parts.Mantissa, denominator), flags, err
+}
+
+// Internal implementation of big.Float conversions
+func convertBigFloat(r *ByteReader, prec uint) (*big.Float, uint, error) {
+	parts, flags, err := ite.ConvertBigTemplate(r, true, false)
+
+	if parts.Mantissa == nil {
+		return nil, flags, err
+	}
+
+	res := new(big.Float).SetPrec(prec)
+	mantissa := new(big.Float).SetInt(parts.Mantissa)
+
+	if parts.Exponent > 0 {
+		res.Quo(mantissa, new(big.Float).SetInt(bigPow10(parts.Exponent)))
+	} else {
+		res.Set(mantissa)
+	}
+
+	if parts.Negative {
+		res.Neg(res)
+	}
+
+	return res, flags, err
+}
+
+// Returns conversion function for type *big.Float
+// with precision of prec bits
+func NewBigFloatConversion(prec uint) func(*ByteReader) (*big.Float, uint, error) {
+	return func(r *ByteReader) (*big.Float, uint, error) {
+		return convertBigFloat(r, prec)
+	}
+}
@@ -0,0 +1,237 @@
+package gonumberio
+
+import (
+	"errors"
+	"io"
+	"math/bits"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+// Sequence of bits packed in 64-bit words
+type Bitset struct {
+	length int
+	words  []uint64
+}
+
+// Matrix of bits with packed rows.
+// Rows may have different lengths.
+type BitMatrix struct {
+	rows []Bitset
+}
+
+// Constructs new BitMatrix with rows of cols bits set to false
+func NewBitMatrix(rows, cols int) *BitMatrix {
+	res := &BitMatrix{rows: make([]Bitset, rows)}
+
+	for i := range res.rows {
+		res.rows[i] = *NewBitset(cols)
+	}
+
+	return res
+}
+
+// Constructs new Bitset of length bits set to false
+func NewBitset(length int) *Bitset {
+	return &Bitset{
+		length: length,
+		words:  make([]uint64, (length+63)/64),
+	}
+}
+
+// Appends one bit to the end
+func (b *Bitset) Append(val bool) {
+	if b.length%64 == 0 {
+		b.words = append(b.words, 0)
+	}
+
+	b.length++
+	b.Set(b.length-1, val)
+}
+
+// Returns bit at index i
+func (b *Bitset) Get(i int) bool {
+	if i < 0 || i >= b.length {
+		panic("Bitset index out of range")
+	}
+
+	return b.words[i/64]&(1<<(uint(i)%64)) != 0
+}
+
+// Returns number of bits
+func (b *Bitset) Len() int {
+	return b.length
+}
+
+// Returns number of bits set to true
+func (b *Bitset) PopCount() int {
+	res := 0
+
+	for _, word := range b.words {
+		res += bits.OnesCount64(word)
+	}
+
+	return res
+}
+
+// Sets bit at index i
+func (b *Bitset) Set(i int, val bool) {
+	if i < 0 || i >= b.length {
+		panic("Bitset index out of range")
+	}
+
+	if val {
+		b.words[i/64] |= 1 << (uint(i) % 64)
+	} else {
+		b.words[i/64] &^= 1 << (uint(i) % 64)
+	}
+}
+
+// Returns underlying words, bit i is stored in word i/64 at position i%64
+func (b *Bitset) Words() []uint64 {
+	return b.words
+}
+
+// Returns bit at the specified row and column
+func (m *BitMatrix) Get(row, col int) bool {
+	return m.rows[row].Get(col)
+}
+
+// Returns number of bits set to true
+func (m *BitMatrix) PopCount() int {
+	res := 0
+
+	for i := range m.rows {
+		res += m.rows[i].PopCount()
+	}
+
+	return res
+}
+
+// Returns row at index i, modifications of the row modify the matrix
+func (m *BitMatrix) Row(i int) *Bitset {
+	return &m.rows[i]
+}
+
+// Returns number of rows
+func (m *BitMatrix) Rows() int {
+	return len(m.rows)
+}
+
+// Sets bit at the specified row and column
+func (m *BitMatrix) Set(row, col int, val bool) {
+	m.rows[row].Set(col, val)
+}
+
+// Read a Bitset from a Reader in the format of ConvertBool
+func ReadBitset(r io.Reader) (*Bitset, error) {
+	return ReadBitsetCustom(r, DefaultChunkSize, ConvertBool)
+}
+
+// Read a Bitset from a Reader with options
+func ReadBitsetCustom(
+	r io.Reader, chunkSize int, conv func(*ByteReader) (bool, uint, error),
+) (*Bitset, error) {
+	res := &Bitset{}
+
+	if err := readBits(r, chunkSize, conv, res.Append, func() {}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Read a BitMatrix from a Reader in the format of ConvertBool,
+// each line is one row
+func ReadBitMatrix(r io.Reader) (*BitMatrix, error) {
+	return ReadBitMatrixCustom(r, DefaultChunkSize, ConvertBool)
+}
+
+// Read a BitMatrix from a Reader with options
+func ReadBitMatrixCustom(
+	r io.Reader, chunkSize int, conv func(*ByteReader) (bool, uint, error),
+) (*BitMatrix, error) {
+	res := &BitMatrix{rows: make([]Bitset, 0)}
+	var row Bitset
+
+	addRow := func() {
+		if row.Len() > 0 {
+			res.rows = append(res.rows, row)
+			row = Bitset{}
+		}
+	}
+
+	if err := readBits(r, chunkSize, conv, row.Append, addRow); err != nil {
+		return nil, err
+	}
+
+	addRow()
+	return res, nil
+}
+
+// Internal implementation of bit reading functions
+func readBits(
+	r io.Reader, chunkSize int, conv func(*ByteReader) (bool, uint, error),
+	add func(bool), newline func(),
+) error {
+	if conv == nil {
+		return errors.New("Conversion function is nil")
+	}
+
+	byteReader := ite.NewByteReader(r, chunkSize)
+
+	for {
+		val, flags, err := conv(byteReader)
+
+		if (flags & ite.HasValue) == ite.HasValue {
+			add(val)
+		}
+
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+		}
+
+		if (flags & ite.HasNewline) == ite.HasNewline {
+			newline()
+		}
+	}
+}
+
+// Write a Bitset to a Writer as one line of characters 0 and 1
+func WriteBitset(w io.Writer, b *Bitset) error {
+	return WriteBitMatrix(w, &BitMatrix{rows: []Bitset{*b}})
+}
+
+// Write a BitMatrix to a Writer, each row as one line of characters 0 and 1
+func WriteBitMatrix(w io.Writer, m *BitMatrix) error {
+	buf := make([]byte, 0, DefaultChunkSize)
+
+	for i := range m.rows {
+		row := &m.rows[i]
+
+		for j := 0; j < row.Len(); j++ {
+			if row.Get(j) {
+				buf = append(buf, '1')
+			} else {
+				buf = append(buf, '0')
+			}
+
+			if len(buf) == cap(buf) {
+				if _, err := w.Write(buf); err != nil {
+					return err
+				}
+
+				buf = buf[:0]
+			}
+		}
+
+		buf = append(buf, '\n')
+	}
+
+	_, err := w.Write(buf)
+	return err
+}
@@ -0,0 +1,86 @@
+package gonumberio_test
+
+import (
+	"strings"
+	"testing"
+
+	nio "github.com/Matej-Chmel/go-number-io"
+)
+
+func TestBitMatrix(t *testing.T) {
+	file, err := openFile[bool](3)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer file.Close()
+	m, err := nio.ReadBitMatrix(file)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var expected [][]bool
+
+	for _, block := range boolD3 {
+		expected = append(expected, block...)
+	}
+
+	if m.Rows() != len(expected) {
+		t.Fatalf("%d rows != %d rows", m.Rows(), len(expected))
+	}
+
+	count := 0
+
+	for i, row := range expected {
+		if m.Row(i).Len() != len(row) {
+			t.Fatalf("Row %d has %d bits, expected %d", i, m.Row(i).Len(), len(row))
+		}
+
+		for j, val := range row {
+			if m.Get(i, j) != val {
+				t.Errorf("Bit (%d, %d) != %t", i, j, val)
+			}
+
+			if val {
+				count++
+			}
+		}
+	}
+
+	if m.PopCount() != count {
+		t.Errorf("PopCount %d != %d", m.PopCount(), count)
+	}
+
+	m.Set(0, 0, false)
+	m.Row(1).Set(5, true)
+
+	checkWritten(t, func(sb *strings.Builder) error {
+		return nio.WriteBitMatrix(sb, m)
+	}, "0\n000001\n11\n0101\n0101\n0101\n01\n01011\n00111\n011\n1\n")
+}
+
+func TestBitset(t *testing.T) {
+	b, err := nio.ReadBitset(strings.NewReader(strings.Repeat("0110", 40)))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b.Len() != 160 || b.PopCount() != 80 || len(b.Words()) != 3 {
+		t.Fatalf("Unexpected bitset of %d bits, %d set", b.Len(), b.PopCount())
+	}
+
+	if b.Get(0) || !b.Get(1) || !b.Get(130) || b.Get(159) {
+		t.Error("Unexpected bit values")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for index after the last bit")
+		}
+	}()
+
+	b.Get(160)
+}
@@ -0,0 +1,212 @@
+package gonumberio
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+// Vocabulary of symbols recognized as boolean values
+type BoolTable struct {
+	// Symbols are compared case-sensitively
+	CaseSensitive bool
+	// Each character is one value, like "0101" in ConvertBool.
+	// All symbols must be single characters.
+	Characters bool
+	// Symbols of false values
+	False []string
+	// Symbols of true values
+	True []string
+}
+
+var (
+	// Vocabulary of ConvertBool
+	CharacterBoolTable = BoolTable{
+		Characters: true,
+		False:      []string{"0"},
+		True:       []string{"1"},
+	}
+
+	// Vocabulary of ConvertBoolWord
+	WordBoolTable = BoolTable{
+		False: []string{"0", "false", "f", "no", "n", "off"},
+		True:  []string{"1", "true", "t", "yes", "y", "on"},
+	}
+)
+
+// Conversion function for type bool that accepts
+// case-insensitive words from WordBoolTable like "true", "No" or "T"
+var ConvertBoolWord = mustBoolConversion(WordBoolTable)
+
+// Returns conversion function for type bool that accepts symbols from table
+func NewBoolConversion(table BoolTable) (func(*ByteReader) (bool, uint, error), error) {
+	if table.Characters {
+		return newBoolCharConversion(table)
+	}
+
+	return newBoolWordConversion(table)
+}
+
+// Adds symbols of one truth value to a lookup table
+func addBoolSymbols(symbols []string, val bool, add func(string, bool) error) error {
+	for _, symbol := range symbols {
+		if symbol == "" {
+			return errors.New("Empty bool symbol")
+		}
+
+		if bytes.ContainsAny([]byte(symbol), " \t\r\n") {
+			return fmt.Errorf("Bool symbol %q contains whitespace", symbol)
+		}
+
+		if err := add(symbol, val); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Builds a lookup table from both truth values of table
+func buildBoolTable(table BoolTable, add func(string, bool) error) error {
+	if err := addBoolSymbols(table.False, false, add); err != nil {
+		return err
+	}
+
+	return addBoolSymbols(table.True, true, add)
+}
+
+// Returns error naming the unrecognized bool token
+func boolSymbolError(token []byte) error {
+	return fmt.Errorf("Unknown bool symbol %q", token)
+}
+
+// Returns error for a symbol that has both truth values
+func boolSymbolConflict(symbol string) error {
+	return fmt.Errorf("Bool symbol %q is both true and false", symbol)
+}
+
+// Returns conversion function for ASCII character vocabularies
+func newBoolCharConversion(table BoolTable) (func(*ByteReader) (bool, uint, error), error) {
+	var known, values [256]bool
+
+	set := func(c byte, val bool) error {
+		if known[c] && values[c] != val {
+			return boolSymbolConflict(string(c))
+		}
+
+		known[c], values[c] = true, val
+		return nil
+	}
+
+	err := buildBoolTable(table, func(symbol string, val bool) error {
+		if len(symbol) != 1 {
+			return fmt.Errorf("Bool symbol %q is not a single character", symbol)
+		}
+
+		c := symbol[0]
+
+		if !table.CaseSensitive && c >= 'A' && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+
+		if !table.CaseSensitive && c >= 'a' && c <= 'z' {
+			if err := set(c-('a'-'A'), val); err != nil {
+				return err
+			}
+		}
+
+		return set(c, val)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return func(r *ByteReader) (bool, uint, error) {
+		for {
+			b, err := r.NextByteConvertNewline()
+
+			if err != nil {
+				return false, 0, err
+			}
+
+			if b == '\n' {
+				return false, ite.HasNewline, nil
+			}
+
+			if known[b] {
+				return values[b], ite.HasValue, nil
+			} else if b == '\t' || b == ' ' {
+				continue
+			}
+
+			return false, 0, unknownBoolChar(r)
+		}
+	}, nil
+}
+
+// Returns conversion function for word vocabularies
+func newBoolWordConversion(table BoolTable) (func(*ByteReader) (bool, uint, error), error) {
+	words := make(map[string]bool)
+	caseSensitive := table.CaseSensitive
+
+	err := buildBoolTable(table, func(symbol string, val bool) error {
+		if !caseSensitive {
+			symbol = string(bytes.ToLower([]byte(symbol)))
+		}
+
+		if prev, ok := words[symbol]; ok && prev != val {
+			return boolSymbolConflict(symbol)
+		}
+
+		words[symbol] = val
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return func(r *ByteReader) (bool, uint, error) {
+		var arr [16]byte
+		token, flags, err := r.NextToken(arr[:0])
+
+		if (flags & ite.HasValue) == 0 {
+			return false, flags, err
+		}
+
+		key := token
+
+		if !caseSensitive {
+			key = bytes.ToLower(token)
+		}
+
+		val, ok := words[string(key)]
+
+		if !ok {
+			return false, 0, boolSymbolError(token)
+		}
+
+		return val, flags, err
+	}, nil
+}
+
+// Returns conversion function for a valid table
+func mustBoolConversion(table BoolTable) func(*ByteReader) (bool, uint, error) {
+	conv, err := NewBoolConversion(table)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return conv
+}
+
+// Returns error naming the token that starts with the previous character
+func unknownBoolChar(r *ByteReader) error {
+	r.MoveBack()
+	token, _, _ := r.NextToken(nil)
+	return boolSymbolError(token)
+}
@@ -0,0 +1,170 @@
+package gonumberio
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+// Header of a serialized Checkpoint
+const checkpointMagic = "NIOCKPT1"
+
+// Flags of a serialized Checkpoint
+const (
+	checkpointAfterSep uint64 = 1 << iota
+	checkpointLineStart
+	checkpointNewBlock
+	checkpointPendingNewline
+)
+
+// Position of a RowReader between two calls of Next
+type Checkpoint struct {
+	// A row separator was read and the following newline is ignored
+	AfterSeparator bool
+	// Index of the 2D slice of the last delivered row
+	Block int
+	// Line number of the first byte that was not read
+	Line int
+	// The next byte starts a new line
+	LineStart bool
+	// An empty line was read after the last delivered row
+	NewBlock bool
+	// Offset of the first byte that was not read
+	Offset int64
+	// A block separator was read and the empty line is not yet processed
+	PendingNewline bool
+	// Number of delivered rows
+	Rows int
+}
+
+// Returns position after the last row returned by Next.
+// Reading from a checkpoint continues with the next row with ResumeRowReader.
+func (rr *RowReader[T]) Checkpoint() Checkpoint {
+	state := rr.byteReader.State()
+
+	return Checkpoint{
+		AfterSeparator: state.AfterSep,
+		Block:          rr.block,
+		Line:           state.Line,
+		LineStart:      state.LineStart,
+		NewBlock:       rr.newBlock,
+		Offset:         state.Offset,
+		PendingNewline: state.PendingNewline,
+		Rows:           rr.rows,
+	}
+}
+
+// Constructs RowReader that continues reading from a checkpoint.
+// Options must be the same as options of the RowReader that returned cp,
+// SkipLines is ignored and Limit counts rows delivered before cp.
+func ResumeRowReader[T any](
+	r io.ReadSeeker, cp Checkpoint, opts Options,
+	conv func(*ByteReader) (T, uint, error)) (*RowReader[T], error) {
+
+	return ResumeRowReaderContext(context.Background(), r, cp, opts, conv)
+}
+
+// Constructs RowReader that continues reading from a checkpoint
+// like ResumeRowReader and stops reading when ctx is done
+func ResumeRowReaderContext[T any](
+	ctx context.Context, r io.ReadSeeker, cp Checkpoint, opts Options,
+	conv func(*ByteReader) (T, uint, error)) (*RowReader[T], error) {
+
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if cp.Offset < 0 || cp.Block < 0 || cp.Line < 1 || cp.Rows < 0 {
+		return nil, errors.New("Invalid checkpoint")
+	}
+
+	if _, err := r.Seek(cp.Offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	byteReader := ite.NewByteReaderOptions(WithContext(ctx, r), opts)
+	byteReader.Restore(ite.ReaderState{
+		AfterSep:       cp.AfterSeparator,
+		Line:           cp.Line,
+		LineStart:      cp.LineStart,
+		Offset:         cp.Offset,
+		PendingNewline: cp.PendingNewline,
+	})
+
+	res := newRowReader(byteReader, opts, conv)
+	res.block = cp.Block
+	res.newBlock = cp.NewBlock
+	res.rows = cp.Rows
+	return res, nil
+}
+
+// Serializes Checkpoint in a compact binary form
+func (cp Checkpoint) MarshalBinary() ([]byte, error) {
+	var flags uint64 = 0
+
+	if cp.AfterSeparator {
+		flags |= checkpointAfterSep
+	}
+
+	if cp.LineStart {
+		flags |= checkpointLineStart
+	}
+
+	if cp.NewBlock {
+		flags |= checkpointNewBlock
+	}
+
+	if cp.PendingNewline {
+		flags |= checkpointPendingNewline
+	}
+
+	buf := []byte(checkpointMagic)
+	buf = binary.AppendUvarint(buf, flags)
+	buf = binary.AppendUvarint(buf, uint64(cp.Offset))
+	buf = binary.AppendUvarint(buf, uint64(cp.Rows))
+	buf = binary.AppendUvarint(buf, uint64(cp.Block))
+	buf = binary.AppendUvarint(buf, uint64(cp.Line))
+	return buf, nil
+}
+
+// Restores Checkpoint serialized by MarshalBinary
+func (cp *Checkpoint) UnmarshalBinary(data []byte) error {
+	if len(data) < len(checkpointMagic) ||
+		string(data[:len(checkpointMagic)]) != checkpointMagic {
+		return errors.New("Data is not a serialized checkpoint")
+	}
+
+	data = data[len(checkpointMagic):]
+	var values [5]uint64
+
+	for i := range values {
+		val, n := binary.Uvarint(data)
+
+		if n <= 0 || val > 1<<62 {
+			return errors.New("Invalid checkpoint")
+		}
+
+		values[i] = val
+		data = data[n:]
+	}
+
+	if len(data) > 0 {
+		return errors.New("Unexpected data after checkpoint")
+	}
+
+	*cp = Checkpoint{
+		AfterSeparator: values[0]&checkpointAfterSep != 0,
+		Block:          int(values[3]),
+		Line:           int(values[4]),
+		LineStart:      values[0]&checkpointLineStart != 0,
+		NewBlock:       values[0]&checkpointNewBlock != 0,
+		Offset:         int64(values[1]),
+		PendingNewline: values[0]&checkpointPendingNewline != 0,
+		Rows:           int(values[2]),
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package gonumberio
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"unsafe"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Conversion function for complex numbers.
+// Accepts notations "1.5+2i", "-3-0.25i", "2i", "3" and "(1.5,2)".
+func ConvertComplex[T constraints.Complex](r *ByteReader) (T, uint, error) {
+	var val T
+	bitSize := int(unsafe.Sizeof(val)) * 8
+	token, flags, err := r.NextToken(nil)
+
+	if (flags & HasValueFlag) == 0 {
+		return 0, flags, err
+	}
+
+	// Tuple notation may contain whitespace after the parenthesis and the comma
+	for token[0] == '(' && bytes.IndexByte(token, ')') < 0 {
+		if err != nil || (flags&ite.HasNewline) == ite.HasNewline {
+			return 0, 0, errors.New("Unterminated complex tuple")
+		}
+
+		if last := token[len(token)-1]; len(token) > 1 && last != ',' {
+			return 0, 0, fmt.Errorf("Whitespace after %q in complex tuple", token)
+		}
+
+		token, flags, err = r.NextToken(token)
+		flags |= HasValueFlag
+	}
+
+	res, parseErr := parseComplex(token, bitSize)
+
+	if parseErr != nil {
+		return 0, 0, parseErr
+	}
+
+	return T(res), flags, err
+}
+
+// Formats complex number as "re+imi"
+func FormatComplex[T constraints.Complex](buf []byte, val T) ([]byte, error) {
+	bitSize := int(unsafe.Sizeof(val)) * 4
+	c := complex128(val)
+	buf = strconv.AppendFloat(buf, real(c), 'f', -1, bitSize)
+
+	if im := imag(c); !math.IsInf(im, 1) && (im >= 0 || im != im) {
+		buf = append(buf, '+')
+	}
+
+	buf = strconv.AppendFloat(buf, imag(c), 'f', -1, bitSize)
+	return append(buf, 'i'), nil
+}
+
+// Parses complex number in algebraic or tuple notation
+func parseComplex(token []byte, bitSize int) (complex128, error) {
+	n := len(token)
+	comma := -1
+
+	if n >= 2 && token[0] == '(' && token[n-1] == ')' {
+		comma = bytes.IndexByte(token, ',')
+	}
+
+	if comma < 0 {
+		if res, err := strconv.ParseComplex(string(token), bitSize); err == nil {
+			return res, nil
+		}
+
+		return 0, fmt.Errorf("Invalid complex number %q", token)
+	}
+
+	re, reErr := strconv.ParseFloat(string(bytes.TrimSpace(token[1:comma])), bitSize/2)
+	im, imErr := strconv.ParseFloat(string(bytes.TrimSpace(token[comma+1:n-1])), bitSize/2)
+
+	if reErr != nil || imErr != nil {
+		return 0, fmt.Errorf("Invalid complex number %q", token)
+	}
+
+	return complex(re, im), nil
+}
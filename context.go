@@ -0,0 +1,83 @@
+package gonumberio
+
+import (
+	"context"
+	"io"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+// Returns a Reader that makes any reading function check ctx
+// before each chunk of r. When ctx is done, reading stops
+// and the error of ctx is returned in PositionError.
+// Returns r if ctx can never be done.
+func WithContext(ctx context.Context, r io.Reader) io.Reader {
+	if ctx.Done() == nil {
+		return r
+	}
+
+	return ite.NewContextReader(ctx, r)
+}
+
+// Read one element of type T from a Reader with options until ctx is done
+func Read0DContext[T any](
+	ctx context.Context, r io.Reader, opts Options,
+	conv func(*ByteReader) (T, uint, error)) (T, error) {
+
+	return Read0DOptions(WithContext(ctx, r), opts, conv)
+}
+
+// Read a 1D slice of type T from a Reader with options until ctx is done
+func Read1DContext[T any](
+	ctx context.Context, r io.Reader, opts Options,
+	conv func(*ByteReader) (T, uint, error)) ([]T, error) {
+
+	return Read1DOptions(WithContext(ctx, r), opts, conv)
+}
+
+// Read a 2D slice of type T from a Reader with options until ctx is done
+func Read2DContext[T any](
+	ctx context.Context, r io.Reader, opts Options,
+	conv func(*ByteReader) (T, uint, error)) ([][]T, error) {
+
+	return Read2DOptions(WithContext(ctx, r), opts, conv)
+}
+
+// Read a 3D slice of type T from a Reader with options until ctx is done
+func Read3DContext[T any](
+	ctx context.Context, r io.Reader, opts Options,
+	conv func(*ByteReader) (T, uint, error)) ([][][]T, error) {
+
+	return Read3DOptions(WithContext(ctx, r), opts, conv)
+}
+
+// Reads T from reader with options until ctx is done.
+// T can be a single element or 1D, 2D or 3D slice.
+func ReadContext[T any](ctx context.Context, reader io.Reader, opts Options) (T, error) {
+	return ReadOptions[T](WithContext(ctx, reader), opts)
+}
+
+// Reads T from data without copying with options until ctx is done.
+// T can be a single element or 1D, 2D or 3D slice.
+func ReadBytesContext[T any](ctx context.Context, data []byte, opts Options) (T, error) {
+	return ReadContext[T](ctx, ite.NewMemoryReader(data, false), opts)
+}
+
+// Reads T from s without copying with options until ctx is done.
+// T can be a single element or 1D, 2D or 3D slice.
+func ReadStringContext[T any](ctx context.Context, s string, opts Options) (T, error) {
+	return ReadContext[T](ctx, ite.NewMemoryReader(stringBytes(s), true), opts)
+}
+
+// Reads T from the file at path with options like ReadFile until ctx is done
+func ReadFileContext[T any](ctx context.Context, path string, opts Options) (T, error) {
+	var res T
+
+	err := withFile(path, func(r io.Reader) error {
+		var err error
+		res, err = ReadContext[T](ctx, r, opts)
+		return err
+	})
+
+	return res, err
+}
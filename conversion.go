@@ -1,7 +1,7 @@
 package gonumberio
 
 import (
-	"fmt"
+	"math/big"
 	r "reflect"
 
 	ite "github.com/Matej-Chmel/go-number-io/internal"
@@ -29,7 +29,7 @@ func ConvertBool(r *ByteReader) (bool, uint, error) {
 		} else if b == '\t' || b == ' ' {
 			continue
 		} else {
-			return false, 0, fmt.Errorf("Unknown bool symbol %c", b)
+			return false, 0, unknownBoolChar(r)
 		}
 	}
 }
@@ -40,9 +40,24 @@ func ConvertByte(r *ByteReader) (byte, uint, error) {
 	return b, ite.HasValue, err
 }
 
-// Conversion function for type float
+// Conversion function for whitespace separated tokens as byte slices.
+// Tokens read from a ByteReader of NewByteReaderBytes are views of its data,
+// tokens read from other inputs are copies.
+func ConvertBytes(r *ByteReader) ([]byte, uint, error) {
+	return r.NextTokenBytes()
+}
+
+// Conversion function for type float.
+// Digits in the buffer of ByteReader are scanned directly,
+// other symbols and the end of the buffer are processed by the template.
 func ConvertFloat[T constraints.Float](r *ByteReader) (T, uint, error) {
 	decMult := T(.1)
+	res, flags, ok := ite.ScanFloat(r, &decMult)
+
+	if ok {
+		return applySign(res, flags), flags, nil
+	}
+
 	processDigit := func(digit uint, flags uint, res T) (T, uint) {
 		if (flags & ite.HasDecimals) == ite.HasDecimals {
 			res += decMult * T(digit)
@@ -54,17 +69,54 @@ func ConvertFloat[T constraints.Float](r *ByteReader) (T, uint, error) {
 		return res, flags | ite.HasValue
 	}
 
-	return ite.ConvertSignedTemplate(r, ite.ProcessFloatNonDigit, processDigit)
+	return ite.ConvertSignedTemplateFrom(
+		r, res, flags, ite.ProcessFloatNonDigit, processDigit)
 }
 
 // Conversion function for floats and integers
 func ConvertSigned[T constraints.Signed](r *ByteReader) (T, uint, error) {
-	return ite.ConvertSignedTemplate[T](r, ite.ProcessIntNonDigit, ite.ProcessDigit)
+	res, flags, ok := ite.ScanInteger[T](r, true)
+
+	if ok {
+		return applySign(res, flags), flags, nil
+	}
+
+	return ite.ConvertSignedTemplateFrom(
+		r, res, flags, ite.ProcessIntNonDigit, ite.ProcessDigit)
 }
 
 // Conversion function for unsigned integers
 func ConvertUnsigned[T constraints.Unsigned](r *ByteReader) (T, uint, error) {
-	return ite.ConvertTemplate[T](r, ite.ProcessUintNonDigit, ite.ProcessDigit)
+	res, flags, ok := ite.ScanInteger[T](r, false)
+
+	if ok {
+		return res, flags, nil
+	}
+
+	return ite.ConvertTemplateFrom(
+		r, res, flags, ite.ProcessUintNonDigit, ite.ProcessDigit)
+}
+
+// Negates res if flags contain IsNegative
+func applySign[T ite.SignedNumber](res T, flags uint) T {
+	if (flags & ite.IsNegative) == ite.IsNegative {
+		return res * T(-1)
+	}
+
+	return res
+}
+
+// Conversion function for whitespace separated tokens as Token
+// with the same views and copies as ConvertBytes
+func ConvertToken(r *ByteReader) (Token, uint, error) {
+	return r.NextTokenBytes()
+}
+
+// Conversion function for whitespace separated tokens as strings
+func ConvertString(r *ByteReader) (string, uint, error) {
+	var arr [32]byte
+	token, flags, err := r.NextTokenView(arr[:0])
+	return string(token), flags, err
 }
 
 // Returns conversion function for generic type T
@@ -80,9 +132,28 @@ func GetConversion[T any]() func(r *ByteReader) (T, uint, error) {
 
 // Internal implementation of GetConversion[T]
 func getConversionImpl(t r.Type) any {
+	switch t {
+	case ite.GetType[[]byte]():
+		return ConvertBytes
+	case ite.GetType[*big.Float]():
+		return ConvertBigFloat
+	case ite.GetType[*big.Int]():
+		return ConvertBigInt
+	case ite.GetType[*big.Rat]():
+		return ConvertBigRat
+	case ite.GetType[Decimal]():
+		return ConvertDecimal
+	case ite.GetType[Token]():
+		return ConvertToken
+	}
+
 	switch kind := t.Kind(); kind {
 	case r.Bool:
 		return ConvertBool
+	case r.Complex64:
+		return ConvertComplex[complex64]
+	case r.Complex128:
+		return ConvertComplex[complex128]
 	case r.Float32:
 		return ConvertFloat[float32]
 	case r.Float64:
@@ -97,6 +168,8 @@ func getConversionImpl(t r.Type) any {
 		return ConvertSigned[int32]
 	case r.Int64:
 		return ConvertSigned[int64]
+	case r.String:
+		return ConvertString
 	case r.Uint:
 		return ConvertUnsigned[uint]
 	case r.Uint8:
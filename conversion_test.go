@@ -0,0 +1,409 @@
+package gonumberio_test
+
+import (
+	"math/big"
+	r "reflect"
+	"strings"
+	"testing"
+
+	nio "github.com/Matej-Chmel/go-number-io"
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+func TestBigFloat(t *testing.T) {
+	conv := nio.NewBigFloatConversion(64)
+	data, err := nio.Read1DCustom(
+		strings.NewReader("0.1 -2.5 100"), nio.DefaultChunkSize, conv)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"0.1", "-2.5", "100"}
+
+	if len(data) != len(expected) {
+		t.Fatalf("%v != %v", data, expected)
+	}
+
+	for i, s := range expected {
+		exp, _, _ := big.ParseFloat(s, 10, 64, big.ToNearestEven)
+
+		if data[i].Cmp(exp) != 0 || data[i].Prec() != 64 {
+			t.Errorf("%v != %v", data[i], exp)
+		}
+	}
+}
+
+func TestBigInt(t *testing.T) {
+	const huge = "123456789012345678901234567890123456789012345678901234567890"
+	data, err := nio.Read[[][]*big.Int](
+		strings.NewReader(huge + " -1\n\n0 -" + huge + "\n"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := [][]string{{huge, "-1"}, {"0", "-" + huge}}
+
+	if len(data) != len(expected) {
+		t.Fatalf("%v != %v", data, expected)
+	}
+
+	for i := range expected {
+		if len(data[i]) != len(expected[i]) {
+			t.Fatalf("%v != %v", data, expected)
+		}
+
+		for j, s := range expected[i] {
+			if data[i][j].String() != s {
+				t.Errorf("%v != %s", data[i][j], s)
+			}
+		}
+	}
+
+	for _, input := range []string{"1.5", "-", "00", "-0", "1 -"} {
+		if _, err := nio.Read1D[*big.Int](strings.NewReader(input)); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
+
+func TestBigRat(t *testing.T) {
+	data, err := nio.Read1D[*big.Rat](strings.NewReader("22/7 -.25 3 1.5/3"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"22/7", "-1/4", "3/1", "1/2"}
+
+	if len(data) != len(expected) {
+		t.Fatalf("%v != %v", data, expected)
+	}
+
+	for i, s := range expected {
+		if data[i].String() != s {
+			t.Errorf("%v != %s", data[i], s)
+		}
+	}
+
+	for _, input := range []string{
+		"1/0", "1/", "1/2/3", "1/2.5", "-", ".", "-.", "00", "-0", "-0.5", "1/00",
+	} {
+		if _, err := nio.Read1D[*big.Rat](strings.NewReader(input)); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	data, err := nio.Read1D[nio.Decimal](
+		strings.NewReader("19.99 -.05 7 .5 0.10 -9223372036854775808"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []nio.Decimal{
+		{Mantissa: 1999, Scale: 2},
+		{Mantissa: -5, Scale: 2},
+		{Mantissa: 7, Scale: 0},
+		{Mantissa: 5, Scale: 1},
+		{Mantissa: 10, Scale: 2},
+		{Mantissa: -9223372036854775808, Scale: 0},
+	}
+
+	if !r.DeepEqual(data, expected) {
+		t.Errorf("%v != %v", data, expected)
+	}
+
+	conv := nio.NewDecimalConversion(2)
+	fixed, err := nio.Read1DCustom(
+		strings.NewReader("1.5 2 -3.250"), nio.DefaultChunkSize, conv)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = []nio.Decimal{
+		{Mantissa: 150, Scale: 2},
+		{Mantissa: 200, Scale: 2},
+		{Mantissa: -325, Scale: 2},
+	}
+
+	if !r.DeepEqual(fixed, expected) {
+		t.Errorf("%v != %v", fixed, expected)
+	}
+
+	for _, input := range []string{"1.005", "9223372036854775808", "92233720368547758.08"} {
+		_, err := nio.Read1DCustom(strings.NewReader(input), nio.DefaultChunkSize, conv)
+
+		if err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+
+	for _, input := range []string{"-", ".", "-.", "00", "-0", "-0.5", "1 -"} {
+		if _, err := nio.Read1D[nio.Decimal](strings.NewReader(input)); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
+
+func TestComplex(t *testing.T) {
+	data, err := nio.Read[[][]complex128](
+		strings.NewReader("1.5+2i -3-0.25i\n(1.5,2) ( -1, 0.5) 2i 3\r\n"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := [][]complex128{
+		{complex(1.5, 2), complex(-3, -0.25)},
+		{complex(1.5, 2), complex(-1, 0.5), complex(0, 2), complex(3, 0)},
+	}
+
+	if !r.DeepEqual(data, expected) {
+		t.Errorf("%v != %v", data, expected)
+	}
+
+	for _, input := range []string{
+		"1+2j", "(1,", "( \n", "1 (1, 2\n)", "(1 2)", "(1,2 3)", "(1, 2 3)", "( 1 ,2)",
+	} {
+		if _, err := nio.Read1D[complex64](strings.NewReader(input)); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
+
+func TestBoolTable(t *testing.T) {
+	data, err := nio.Read2DCustom(
+		strings.NewReader("true False yes\r\nT n 0 1 ON\n"),
+		nio.DefaultChunkSize, nio.ConvertBoolWord)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := [][]bool{{true, false, true}, {true, false, false, true, true}}
+
+	if !r.DeepEqual(data, expected) {
+		t.Errorf("%v != %v", data, expected)
+	}
+
+	if _, err = nio.Read1DCustom(
+		strings.NewReader("true maybe"), nio.DefaultChunkSize, nio.ConvertBoolWord,
+	); err == nil || !strings.Contains(err.Error(), `"maybe"`) {
+		t.Errorf("Expected error naming the token, got %v", err)
+	}
+
+	conv, err := nio.NewBoolConversion(nio.BoolTable{
+		Characters: true, False: []string{"."}, True: []string{"#", "x"},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	chars, err := nio.Read2DCustom(
+		strings.NewReader("#.X\n..#"), nio.DefaultChunkSize, conv)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = [][]bool{{true, false, true}, {false, false, true}}
+
+	if !r.DeepEqual(chars, expected) {
+		t.Errorf("%v != %v", chars, expected)
+	}
+
+	if _, err = nio.Read1D[bool](strings.NewReader("0 1 true")); err == nil ||
+		!strings.Contains(err.Error(), `"true"`) {
+		t.Errorf("Expected error naming the token, got %v", err)
+	}
+
+	if _, err = nio.NewBoolConversion(nio.BoolTable{
+		False: []string{"no"}, True: []string{"No"},
+	}); err == nil {
+		t.Error("Expected error for conflicting symbols")
+	}
+}
+
+func TestSymbolTable(t *testing.T) {
+	table, err := nio.NewSymbolTable[uint8]("A", "C", "G", "T")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := nio.Read2DCustom(
+		strings.NewReader("A C G T\nT T A\n"), nio.DefaultChunkSize, table.Conversion())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := [][]uint8{{0, 1, 2, 3}, {3, 3, 0}}
+
+	if !r.DeepEqual(data, expected) {
+		t.Errorf("%v != %v", data, expected)
+	}
+
+	var sb strings.Builder
+
+	if err = nio.Write2DCustom(&sb, nio.DefaultChunkSize, data, table.Format); err != nil {
+		t.Fatal(err)
+	} else if sb.String() != "A C G T\nT T A\n" {
+		t.Errorf("Unexpected output %q", sb.String())
+	}
+
+	if _, err = nio.Read1DCustom(
+		strings.NewReader("A N"), nio.DefaultChunkSize, table.Conversion(),
+	); err == nil || !strings.Contains(err.Error(), `"N"`) {
+		t.Errorf("Expected error naming the symbol, got %v", err)
+	}
+
+	levels, err := nio.NewSymbolTableMap(map[string]int{"low": -1, "mid": 0, "high": 1})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	coded, err := nio.Read1DCustom(strings.NewReader("high low unknown"),
+		nio.DefaultChunkSize, levels.WithFallback(-99).Conversion())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := []int{1, -1, -99}; !r.DeepEqual(coded, expected) {
+		t.Errorf("%v != %v", coded, expected)
+	}
+
+	if _, err = nio.Read1DCustom(strings.NewReader("unknown"),
+		nio.DefaultChunkSize, levels.Conversion()); err == nil {
+		t.Error("WithFallback changed the original table")
+	}
+
+	if _, err = nio.NewSymbolTableMap(map[string]int{"a": 1, "b": 1}); err == nil {
+		t.Error("Expected error for duplicate codes")
+	}
+}
+
+func TestString(t *testing.T) {
+	input := "id1 3.5 x\r\nid2   -1\n\nid3\n"
+	data, err := nio.Read[[][][]string](strings.NewReader(input))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := [][][]string{{{"id1", "3.5", "x"}, {"id2", "-1"}}, {{"id3"}}}
+
+	if !r.DeepEqual(data, expected) {
+		t.Errorf("%v != %v", data, expected)
+	}
+
+	views, err := nio.Read2D[[]byte](strings.NewReader(input))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(views) != 3 || string(views[0][2]) != "x" || string(views[2][0]) != "id3" {
+		t.Errorf("Unexpected tokens %q", views)
+	}
+
+	tokens, err := nio.Read[[][][]nio.Token](strings.NewReader(input))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tokens) != 2 || string(tokens[0][1][1]) != "-1" || string(tokens[1][0][0]) != "id3" {
+		t.Errorf("Unexpected tokens %q", tokens)
+	}
+
+	data1D := []byte("ab cd")
+	shared, err := nio.ReadBytes[[]nio.Token](data1D)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data1D[0] = 'x'
+
+	if len(shared) != 2 || string(shared[0]) != "xb" {
+		t.Errorf("Expected views of the data, got %q", shared)
+	}
+
+	// A byte slice is still a dimension of uint8 elements
+	if numbers, err := nio.Read[[]byte](strings.NewReader("1 2")); err != nil {
+		t.Fatal(err)
+	} else if !r.DeepEqual(numbers, []byte{1, 2}) {
+		t.Errorf("%v != [1 2]", numbers)
+	}
+}
+
+// Reads input with conv and with the template function without scanning,
+// results and errors must be the same
+func checkScan[T any](
+	t *testing.T, input string, conv, template func(*nio.ByteReader) (T, uint, error),
+) {
+	for _, chunkSize := range []int{1, 2, 3, 7, nio.DefaultChunkSize} {
+		opts := nio.Options{
+			BlockMarker: "--", BlockSeparator: '|', ChunkSize: chunkSize, RowSeparator: ';',
+		}
+		expected, expectedErr := nio.Read3DOptions(strings.NewReader(input), opts, template)
+		actual, err := nio.Read3DOptions(strings.NewReader(input), opts, conv)
+
+		if (err == nil) != (expectedErr == nil) ||
+			(err != nil && err.Error() != expectedErr.Error()) {
+			t.Errorf("%q, chunk size %d: error %v != %v", input, chunkSize, err, expectedErr)
+		} else if !r.DeepEqual(actual, expected) {
+			t.Errorf("%q, chunk size %d: %v != %v", input, chunkSize, actual, expected)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	inputs := []string{
+		"1 22 -333\n4444\t55555\r\n\r\n-6 7;8|9\n--\n10",
+		"  12  -0 3", "00", "10 100 -10", "1-2", "--1", "1.5", "7x 8", "x", "9 y",
+		"12345678901234567890 255 256", "1;\n2 ;  \n3|\n\n4\r\n--  \n5",
+		"0.5 -0.25 .75 3. 1.2.3", "-.5 0.0 12.03400 -7", "1\r2", "\n\n1\n",
+	}
+
+	floatTemplate := func(r *nio.ByteReader) (float64, uint, error) {
+		decMult := .1
+
+		return ite.ConvertSignedTemplate(r, ite.ProcessFloatNonDigit,
+			func(digit uint, flags uint, res float64) (float64, uint) {
+				if (flags & ite.HasDecimals) == ite.HasDecimals {
+					res += decMult * float64(digit)
+					decMult *= .1
+				} else {
+					res = res*10. + float64(digit)
+				}
+
+				return res, flags | ite.HasValue
+			})
+	}
+
+	for _, input := range inputs {
+		checkScan(t, input, nio.ConvertSigned[int64],
+			func(r *nio.ByteReader) (int64, uint, error) {
+				return ite.ConvertSignedTemplate(r, ite.ProcessIntNonDigit, ite.ProcessDigit[int64])
+			})
+		checkScan(t, input, nio.ConvertSigned[int8],
+			func(r *nio.ByteReader) (int8, uint, error) {
+				return ite.ConvertSignedTemplate(r, ite.ProcessIntNonDigit, ite.ProcessDigit[int8])
+			})
+		checkScan(t, input, nio.ConvertUnsigned[uint8],
+			func(r *nio.ByteReader) (uint8, uint, error) {
+				return ite.ConvertTemplate(r, ite.ProcessUintNonDigit, ite.ProcessDigit[uint8])
+			})
+		checkScan(t, input, nio.ConvertFloat[float64], floatTemplate)
+	}
+}
@@ -0,0 +1,73 @@
+package gonumberio
+
+import (
+	"fmt"
+	"strconv"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+const (
+	// Maximum number of decimal places of Decimal
+	MaxDecimalScale uint8 = ite.MaxDecimalScale
+)
+
+// Exact fixed-point decimal number equal to Mantissa / 10^Scale
+type Decimal struct {
+	Mantissa int64
+	Scale    uint8
+}
+
+// Appends exact text form of the decimal to buf
+func (d Decimal) Append(buf []byte) []byte {
+	magnitude := uint64(d.Mantissa)
+
+	if d.Mantissa < 0 {
+		buf = append(buf, '-')
+		magnitude = -magnitude
+	}
+
+	digits := strconv.AppendUint(nil, magnitude, 10)
+
+	if d.Scale == 0 {
+		return append(buf, digits...)
+	}
+
+	scale := int(d.Scale)
+
+	for len(digits) <= scale {
+		digits = append([]byte{'0'}, digits...)
+	}
+
+	buf = append(buf, digits[:len(digits)-scale]...)
+	buf = append(buf, '.')
+	return append(buf, digits[len(digits)-scale:]...)
+}
+
+// Returns exact text form of the decimal
+func (d Decimal) String() string {
+	return string(d.Append(nil))
+}
+
+// Conversion function for type Decimal.
+// Scale of the result is the number of decimal places written in the input.
+func ConvertDecimal(r *ByteReader) (Decimal, uint, error) {
+	mantissa, scale, flags, err := ite.ConvertDecimalTemplate(r, MaxDecimalScale, false)
+	return Decimal{Mantissa: mantissa, Scale: scale}, flags, err
+}
+
+// Returns conversion function for type Decimal with exactly scale decimal places.
+// Values that need more decimal places are rejected.
+func NewDecimalConversion(scale uint8) func(*ByteReader) (Decimal, uint, error) {
+	if scale > MaxDecimalScale {
+		return func(r *ByteReader) (Decimal, uint, error) {
+			return Decimal{}, 0, fmt.Errorf(
+				"Decimal scale %d is greater than %d", scale, MaxDecimalScale)
+		}
+	}
+
+	return func(r *ByteReader) (Decimal, uint, error) {
+		mantissa, resScale, flags, err := ite.ConvertDecimalTemplate(r, scale, true)
+		return Decimal{Mantissa: mantissa, Scale: resScale}, flags, err
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	r "reflect"
 
 	ite "github.com/Matej-Chmel/go-number-io/internal"
@@ -41,106 +42,74 @@ func dynamicError(aType r.Type) (any, error) {
 	return nil, fmt.Errorf("Type %v doesn't have default conversion", aType)
 }
 
-// Reads 1D slice of type aType
-func dynamicRead1D(reader io.Reader, chunkSize int, aType r.Type) (any, error) {
-	switch kind := aType.Kind(); kind {
-	case r.Bool:
-		return Read1DCustom(reader, chunkSize, ConvertBool)
-	case r.Float32:
-		return Read1DCustom(reader, chunkSize, ConvertFloat[float32])
-	case r.Float64:
-		return Read1DCustom(reader, chunkSize, ConvertFloat[float64])
-	case r.Int:
-		return Read1DCustom(reader, chunkSize, ConvertSigned[int])
-	case r.Int8:
-		return Read1DCustom(reader, chunkSize, ConvertSigned[int8])
-	case r.Int16:
-		return Read1DCustom(reader, chunkSize, ConvertSigned[int16])
-	case r.Int32:
-		return Read1DCustom(reader, chunkSize, ConvertSigned[int32])
-	case r.Int64:
-		return Read1DCustom(reader, chunkSize, ConvertSigned[int64])
-	case r.Uint:
-		return Read1DCustom(reader, chunkSize, ConvertUnsigned[uint])
-	case r.Uint8:
-		return Read1DCustom(reader, chunkSize, ConvertUnsigned[uint8])
-	case r.Uint16:
-		return Read1DCustom(reader, chunkSize, ConvertUnsigned[uint16])
-	case r.Uint32:
-		return Read1DCustom(reader, chunkSize, ConvertUnsigned[uint32])
-	case r.Uint64:
-		return Read1DCustom(reader, chunkSize, ConvertUnsigned[uint64])
+// Reads slice of type aType with the specified number of dimensions
+func dynamicRead(
+	reader io.Reader, opts Options, dims uint, aType r.Type) (any, error) {
+
+	switch aType {
+	case ite.GetType[*big.Float]():
+		return dynamicReadDims(reader, opts, dims, ConvertBigFloat)
+	case ite.GetType[*big.Int]():
+		return dynamicReadDims(reader, opts, dims, ConvertBigInt)
+	case ite.GetType[*big.Rat]():
+		return dynamicReadDims(reader, opts, dims, ConvertBigRat)
+	case ite.GetType[Decimal]():
+		return dynamicReadDims(reader, opts, dims, ConvertDecimal)
+	case ite.GetType[Token]():
+		return dynamicReadDims(reader, opts, dims, ConvertToken)
 	}
 
-	return dynamicError(aType)
-}
-
-// Reads 2D slice of type aType
-func dynamicRead2D(reader io.Reader, chunkSize int, aType r.Type) (any, error) {
 	switch kind := aType.Kind(); kind {
 	case r.Bool:
-		return Read2DCustom(reader, chunkSize, ConvertBool)
+		return dynamicReadDims(reader, opts, dims, ConvertBool)
+	case r.Complex64:
+		return dynamicReadDims(reader, opts, dims, ConvertComplex[complex64])
+	case r.Complex128:
+		return dynamicReadDims(reader, opts, dims, ConvertComplex[complex128])
 	case r.Float32:
-		return Read2DCustom(reader, chunkSize, ConvertFloat[float32])
+		return dynamicReadDims(reader, opts, dims, ConvertFloat[float32])
 	case r.Float64:
-		return Read2DCustom(reader, chunkSize, ConvertFloat[float64])
+		return dynamicReadDims(reader, opts, dims, ConvertFloat[float64])
 	case r.Int:
-		return Read2DCustom(reader, chunkSize, ConvertSigned[int])
+		return dynamicReadDims(reader, opts, dims, ConvertSigned[int])
 	case r.Int8:
-		return Read2DCustom(reader, chunkSize, ConvertSigned[int8])
+		return dynamicReadDims(reader, opts, dims, ConvertSigned[int8])
 	case r.Int16:
-		return Read2DCustom(reader, chunkSize, ConvertSigned[int16])
+		return dynamicReadDims(reader, opts, dims, ConvertSigned[int16])
 	case r.Int32:
-		return Read2DCustom(reader, chunkSize, ConvertSigned[int32])
+		return dynamicReadDims(reader, opts, dims, ConvertSigned[int32])
 	case r.Int64:
-		return Read2DCustom(reader, chunkSize, ConvertSigned[int64])
+		return dynamicReadDims(reader, opts, dims, ConvertSigned[int64])
+	case r.String:
+		return dynamicReadDims(reader, opts, dims, ConvertString)
 	case r.Uint:
-		return Read2DCustom(reader, chunkSize, ConvertUnsigned[uint])
+		return dynamicReadDims(reader, opts, dims, ConvertUnsigned[uint])
 	case r.Uint8:
-		return Read2DCustom(reader, chunkSize, ConvertUnsigned[uint8])
+		return dynamicReadDims(reader, opts, dims, ConvertUnsigned[uint8])
 	case r.Uint16:
-		return Read2DCustom(reader, chunkSize, ConvertUnsigned[uint16])
+		return dynamicReadDims(reader, opts, dims, ConvertUnsigned[uint16])
 	case r.Uint32:
-		return Read2DCustom(reader, chunkSize, ConvertUnsigned[uint32])
+		return dynamicReadDims(reader, opts, dims, ConvertUnsigned[uint32])
 	case r.Uint64:
-		return Read2DCustom(reader, chunkSize, ConvertUnsigned[uint64])
+		return dynamicReadDims(reader, opts, dims, ConvertUnsigned[uint64])
 	}
 
 	return dynamicError(aType)
 }
 
-// Reads 3D slice of type aType
-func dynamicRead3D(reader io.Reader, chunkSize int, aType r.Type) (any, error) {
-	switch kind := aType.Kind(); kind {
-	case r.Bool:
-		return Read3DCustom(reader, chunkSize, ConvertBool)
-	case r.Float32:
-		return Read3DCustom(reader, chunkSize, ConvertFloat[float32])
-	case r.Float64:
-		return Read3DCustom(reader, chunkSize, ConvertFloat[float64])
-	case r.Int:
-		return Read3DCustom(reader, chunkSize, ConvertSigned[int])
-	case r.Int8:
-		return Read3DCustom(reader, chunkSize, ConvertSigned[int8])
-	case r.Int16:
-		return Read3DCustom(reader, chunkSize, ConvertSigned[int16])
-	case r.Int32:
-		return Read3DCustom(reader, chunkSize, ConvertSigned[int32])
-	case r.Int64:
-		return Read3DCustom(reader, chunkSize, ConvertSigned[int64])
-	case r.Uint:
-		return Read3DCustom(reader, chunkSize, ConvertUnsigned[uint])
-	case r.Uint8:
-		return Read3DCustom(reader, chunkSize, ConvertUnsigned[uint8])
-	case r.Uint16:
-		return Read3DCustom(reader, chunkSize, ConvertUnsigned[uint16])
-	case r.Uint32:
-		return Read3DCustom(reader, chunkSize, ConvertUnsigned[uint32])
-	case r.Uint64:
-		return Read3DCustom(reader, chunkSize, ConvertUnsigned[uint64])
+// Reads 1D, 2D or 3D slice of T with conversion function conv
+func dynamicReadDims[T any](
+	reader io.Reader, opts Options, dims uint,
+	conv func(*ByteReader) (T, uint, error)) (any, error) {
+
+	switch dims {
+	case 0, 1:
+		return Read1DOptions(reader, opts, conv)
+	case 2:
+		return Read2DOptions(reader, opts, conv)
 	}
 
-	return dynamicError(aType)
+	return Read3DOptions(reader, opts, conv)
 }
 
 // Reads T from reader.
@@ -154,8 +123,14 @@ func Read[T any](reader io.Reader) (T, error) {
 func ReadCustom[T any](
 	reader io.Reader, chunkSize int) (T, error) {
 
+	return ReadOptions[T](reader, Options{ChunkSize: chunkSize})
+}
+
+// Reads T from reader with options.
+// T can be a single element or 1D, 2D or 3D slice.
+func ReadOptions[T any](reader io.Reader, opts Options) (T, error) {
 	info := ite.Descend[T]()
-	a, err := readAny[T](chunkSize, &info, reader)
+	a, err := readAny[T](opts, &info, reader)
 
 	if err != nil {
 		var res T
@@ -167,20 +142,15 @@ func ReadCustom[T any](
 
 // Internal implementation of ReadCustom[T]
 func readAny[T any](
-	chunkSize int, info *ite.DescendInfo, reader io.Reader) (any, error) {
+	opts Options, info *ite.DescendInfo, reader io.Reader) (any, error) {
 
 	if !info.Supported {
 		var res T
 		return nil, fmt.Errorf("Type %T is not supported", res)
 	}
 
-	switch info.Dimensions {
-	case 0, 1:
-		return dynamicRead1D(reader, chunkSize, info.ElementType)
-	case 2:
-		return dynamicRead2D(reader, chunkSize, info.ElementType)
-	case 3:
-		return dynamicRead3D(reader, chunkSize, info.ElementType)
+	if info.Dimensions <= 3 {
+		return dynamicRead(reader, opts, info.Dimensions, info.ElementType)
 	}
 
 	var res T
@@ -0,0 +1,72 @@
+package gonumberio
+
+import (
+	"io"
+	"os"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+// Reads T from the file at path.
+// T can be a single element or 1D, 2D or 3D slice.
+// On Linux, the file is mapped into memory and parsed without copying,
+// elsewhere or if the file cannot be mapped, it is read through a buffer.
+func ReadFile[T any](path string) (T, error) {
+	return ReadFileOptions[T](path, Options{})
+}
+
+// Reads T from the file at path with options like ReadFile
+func ReadFileOptions[T any](path string, opts Options) (T, error) {
+	var res T
+
+	err := withFile(path, func(r io.Reader) error {
+		var err error
+		res, err = ReadOptions[T](r, opts)
+		return err
+	})
+
+	return res, err
+}
+
+// Reads T from the file at path with read like ReadFile.
+// The ByteReader is valid only until read returns.
+// If the file cannot be mapped, a buffer of chunkSize bytes is used.
+func ReadFileFrom[T any](
+	path string, chunkSize int, read func(*ByteReader) (T, error)) (T, error) {
+
+	var res T
+
+	err := withFile(path, func(r io.Reader) error {
+		var err error
+		res, err = read(ite.NewByteReader(r, chunkSize))
+		return err
+	})
+
+	return res, err
+}
+
+// Opens the file at path, maps it into memory if possible and calls read.
+// The mapping is released and the file closed before returning,
+// even if read fails or panics.
+func withFile(path string, read func(io.Reader) error) (err error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+	data, unmap, mapErr := ite.MapFile(file)
+
+	if mapErr != nil {
+		return read(file)
+	}
+
+	defer func() {
+		if unmapErr := unmap(); err == nil {
+			err = unmapErr
+		}
+	}()
+
+	return read(ite.NewMemoryReader(data, true))
+}
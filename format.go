@@ -0,0 +1,131 @@
+package gonumberio
+
+import (
+	"math/big"
+	r "reflect"
+	"strconv"
+	"unsafe"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Format function for type *big.Float
+func FormatBigFloat(buf []byte, val *big.Float) ([]byte, error) {
+	return val.Append(buf, 'f', -1), nil
+}
+
+// Format function for type *big.Int
+func FormatBigInt(buf []byte, val *big.Int) ([]byte, error) {
+	return val.Append(buf, 10), nil
+}
+
+// Format function for type *big.Rat
+func FormatBigRat(buf []byte, val *big.Rat) ([]byte, error) {
+	return append(buf, val.RatString()...), nil
+}
+
+// Format function for type bool
+func FormatBool(buf []byte, val bool) ([]byte, error) {
+	if val {
+		return append(buf, '1'), nil
+	}
+
+	return append(buf, '0'), nil
+}
+
+// Format function for byte slices
+func FormatBytes(buf []byte, val []byte) ([]byte, error) {
+	return append(buf, val...), nil
+}
+
+// Format function for type Decimal
+func FormatDecimal(buf []byte, val Decimal) ([]byte, error) {
+	return val.Append(buf), nil
+}
+
+// Format function for floats
+func FormatFloat[T constraints.Float](buf []byte, val T) ([]byte, error) {
+	bitSize := int(unsafe.Sizeof(val)) * 8
+	return strconv.AppendFloat(buf, float64(val), 'f', -1, bitSize), nil
+}
+
+// Format function for signed integers
+func FormatSigned[T constraints.Signed](buf []byte, val T) ([]byte, error) {
+	return strconv.AppendInt(buf, int64(val), 10), nil
+}
+
+// Format function for type string
+func FormatString(buf []byte, val string) ([]byte, error) {
+	return append(buf, val...), nil
+}
+
+// Format function for unsigned integers
+func FormatUnsigned[T constraints.Unsigned](buf []byte, val T) ([]byte, error) {
+	return strconv.AppendUint(buf, uint64(val), 10), nil
+}
+
+// Returns format function for generic type T
+func GetFormat[T any]() func([]byte, T) ([]byte, error) {
+	a := getFormatImpl(ite.GetType[T]())
+
+	if fn, ok := a.(func([]byte, T) ([]byte, error)); ok {
+		return fn
+	}
+
+	return nil
+}
+
+// Internal implementation of GetFormat[T]
+func getFormatImpl(t r.Type) any {
+	switch t {
+	case ite.GetType[[]byte]():
+		return FormatBytes
+	case ite.GetType[*big.Float]():
+		return FormatBigFloat
+	case ite.GetType[*big.Int]():
+		return FormatBigInt
+	case ite.GetType[*big.Rat]():
+		return FormatBigRat
+	case ite.GetType[Decimal]():
+		return FormatDecimal
+	}
+
+	switch kind := t.Kind(); kind {
+	case r.Bool:
+		return FormatBool
+	case r.Complex64:
+		return FormatComplex[complex64]
+	case r.Complex128:
+		return FormatComplex[complex128]
+	case r.Float32:
+		return FormatFloat[float32]
+	case r.Float64:
+		return FormatFloat[float64]
+	case r.Int:
+		return FormatSigned[int]
+	case r.Int8:
+		return FormatSigned[int8]
+	case r.Int16:
+		return FormatSigned[int16]
+	case r.Int32:
+		return FormatSigned[int32]
+	case r.Int64:
+		return FormatSigned[int64]
+	case r.String:
+		return FormatString
+	case r.Uint:
+		return FormatUnsigned[uint]
+	case r.Uint8:
+		return FormatUnsigned[uint8]
+	case r.Uint16:
+		return FormatUnsigned[uint16]
+	case r.Uint32:
+		return FormatUnsigned[uint32]
+	case r.Uint64:
+		return FormatUnsigned[uint64]
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package gonumberio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Read lines of characters as a rectangular 2D slice of bytes,
+// each byte except newlines is one cell
+func ReadGrid(r io.Reader) ([][]byte, error) {
+	return ReadGridCustom(r, DefaultChunkSize, false, true,
+		func(c rune) (byte, error) { return byte(c), nil })
+}
+
+// Read lines of single digits as a rectangular 2D slice of integers
+func ReadDigitGrid[T constraints.Integer](r io.Reader) ([][]T, error) {
+	return ReadGridCustom(r, DefaultChunkSize, false, true,
+		func(c rune) (T, error) {
+			if c < '0' || c > '9' {
+				return 0, fmt.Errorf("Character %q is not a digit", c)
+			}
+
+			return T(c - '0'), nil
+		})
+}
+
+// Read lines of characters as a rectangular 2D slice of values from table
+func ReadGridMapped[T any](r io.Reader, table map[rune]T) ([][]T, error) {
+	return ReadGridCustom(r, DefaultChunkSize, true, true,
+		func(c rune) (T, error) {
+			if val, ok := table[c]; ok {
+				return val, nil
+			}
+
+			var res T
+			return res, fmt.Errorf("Character %q is not in the table", c)
+		})
+}
+
+// Read lines of UTF-8 characters as a rectangular 2D slice of runes
+func ReadRuneGrid(r io.Reader) ([][]rune, error) {
+	return ReadGridCustom(r, DefaultChunkSize, true, true,
+		func(c rune) (rune, error) { return c, nil })
+}
+
+// Read lines of characters as a 2D slice of T with options.
+// Each character is converted with conv, if decodeRunes is false,
+// each byte is one character. Empty lines are skipped,
+// if rectangular is true, all rows must have the same length.
+func ReadGridCustom[T any](
+	r io.Reader, chunkSize int, decodeRunes bool, rectangular bool,
+	conv func(rune) (T, error),
+) ([][]T, error) {
+	if conv == nil {
+		return nil, errors.New("Conversion function is nil")
+	}
+
+	byteReader := ite.NewByteReader(r, chunkSize)
+	res := make([][]T, 0)
+	row := make([]T, 0)
+
+	addRow := func() error {
+		if len(row) == 0 {
+			return nil
+		}
+
+		if rectangular && len(res) > 0 && len(row) != len(res[0]) {
+			return fmt.Errorf("Row %d has %d cells, expected %d",
+				len(res), len(row), len(res[0]))
+		}
+
+		res = append(res, row)
+		row = make([]T, 0, len(row))
+		return nil
+	}
+
+	for {
+		c, err := nextGridChar(byteReader, decodeRunes)
+
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if c == '\n' {
+			if err = addRow(); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		val, err := conv(c)
+
+		if err != nil {
+			return nil, err
+		}
+
+		row = append(row, val)
+	}
+
+	if err := addRow(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Returns next character of a grid with "\r\n" converted to "\n"
+func nextGridChar(r *ByteReader, decodeRunes bool) (rune, error) {
+	b, err := r.NextByteConvertNewline()
+
+	if err != nil || !decodeRunes || b < utf8.RuneSelf {
+		return rune(b), err
+	}
+
+	var buf [utf8.UTFMax]byte
+	buf[0] = b
+	n := 1
+
+	for ; n < utf8.UTFMax && !utf8.FullRune(buf[:n]); n++ {
+		if buf[n], err = r.NextByte(); err != nil {
+			return 0, errors.New("Incomplete UTF-8 character")
+		}
+	}
+
+	c, size := utf8.DecodeRune(buf[:n])
+
+	if c == utf8.RuneError && size <= 1 {
+		return 0, errors.New("Invalid UTF-8 character")
+	}
+
+	return c, nil
+}
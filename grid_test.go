@@ -0,0 +1,62 @@
+package gonumberio_test
+
+import (
+	r "reflect"
+	"strings"
+	"testing"
+
+	nio "github.com/Matej-Chmel/go-number-io"
+)
+
+func TestGrid(t *testing.T) {
+	grid, err := nio.ReadGrid(strings.NewReader("#..#\r\n.##.\n\n#..#\n"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := [][]byte{[]byte("#..#"), []byte(".##."), []byte("#..#")}
+
+	if !r.DeepEqual(grid, expected) {
+		t.Errorf("%q != %q", grid, expected)
+	}
+
+	if _, err = nio.ReadGrid(strings.NewReader("#..#\n.#.\n")); err == nil {
+		t.Error("Expected error for a grid that is not rectangular")
+	}
+
+	digits, err := nio.ReadDigitGrid[int](strings.NewReader("12345\n67890"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := [][]int{{1, 2, 3, 4, 5}, {6, 7, 8, 9, 0}}; !r.DeepEqual(digits, expected) {
+		t.Errorf("%v != %v", digits, expected)
+	}
+
+	if _, err = nio.ReadDigitGrid[int](strings.NewReader("12 45")); err == nil {
+		t.Error("Expected error for a character that is not a digit")
+	}
+
+	runes, err := nio.ReadRuneGrid(strings.NewReader("ž█a\n→ b\n"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := [][]rune{{'ž', '█', 'a'}, {'→', ' ', 'b'}}; !r.DeepEqual(runes, expected) {
+		t.Errorf("%q != %q", runes, expected)
+	}
+
+	walls, err := nio.ReadGridMapped(
+		strings.NewReader("#.\n.#"), map[rune]bool{'#': true, '.': false})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := [][]bool{{true, false}, {false, true}}; !r.DeepEqual(walls, expected) {
+		t.Errorf("%v != %v", walls, expected)
+	}
+}
@@ -0,0 +1,253 @@
+package gonumberio
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+// Header of a saved RowIndex
+const rowIndexMagic = "NIOROWS1"
+
+// Byte offsets of rows and 2D slices of a text input.
+// Rows are lines that are not empty, 2D slices are separated by empty lines.
+type RowIndex struct {
+	// Indices into Rows of the first row of each 2D slice
+	Blocks []int
+	// Offset of the first byte of each row
+	Rows []int64
+	// Size of the indexed input in bytes
+	Size int64
+}
+
+// Lazy 2D or 3D slice of type T over an indexed input.
+// Rows are parsed only when they are requested.
+type IndexedMatrix[T any] struct {
+	conv  func(*ByteReader) (T, uint, error)
+	index *RowIndex
+	opts  Options
+	r     io.ReaderAt
+}
+
+// Builds RowIndex of a Reader in one pass
+func BuildRowIndex(r io.Reader) (*RowIndex, error) {
+	return BuildRowIndexCustom(r, DefaultChunkSize)
+}
+
+// Builds RowIndex of a Reader in one pass with options
+func BuildRowIndexCustom(r io.Reader, chunkSize int) (*RowIndex, error) {
+	rows, blocks, size, err := ite.ScanRowOffsets(r, chunkSize)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &RowIndex{Blocks: blocks, Rows: rows, Size: size}, nil
+}
+
+// Reads RowIndex saved by RowIndex.WriteTo
+func ReadRowIndex(r io.Reader) (*RowIndex, error) {
+	reader := bufio.NewReader(r)
+	magic := make([]byte, len(rowIndexMagic))
+
+	if _, err := io.ReadFull(reader, magic); err != nil || string(magic) != rowIndexMagic {
+		return nil, errors.New("Input is not a saved row index")
+	}
+
+	var values [3]uint64
+
+	readValue := func() (uint64, error) {
+		val, err := binary.ReadUvarint(reader)
+
+		if err == io.EOF {
+			return 0, io.ErrUnexpectedEOF
+		}
+
+		return val, err
+	}
+
+	for i := range values {
+		val, err := readValue()
+
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = val
+	}
+
+	if values[0] > math.MaxInt64 {
+		return nil, fmt.Errorf("Invalid size %d", values[0])
+	}
+
+	res := &RowIndex{
+		Blocks: make([]int, 0, min(values[2], 1<<16)),
+		Rows:   make([]int64, 0, min(values[1], 1<<16)),
+		Size:   int64(values[0]),
+	}
+
+	var prevRow uint64 = 0
+
+	for i := uint64(0); i < values[1]; i++ {
+		delta, err := readValue()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if (i > 0 && delta == 0) || delta > math.MaxInt64-prevRow {
+			return nil, fmt.Errorf("Invalid offset of row %d", i)
+		}
+
+		prevRow += delta
+		res.Rows = append(res.Rows, int64(prevRow))
+	}
+
+	prevBlock := 0
+
+	for i := uint64(0); i < values[2]; i++ {
+		delta, err := readValue()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if (i > 0 && delta == 0) || delta > uint64(math.MaxInt-prevBlock) {
+			return nil, fmt.Errorf("Invalid first row of 2D slice %d", i)
+		}
+
+		prevBlock += int(delta)
+		res.Blocks = append(res.Blocks, prevBlock)
+	}
+
+	return res, res.validate()
+}
+
+// Returns an error if offsets are not increasing or out of range
+func (idx *RowIndex) validate() error {
+	for i, offset := range idx.Rows {
+		if offset < 0 || offset >= idx.Size || (i > 0 && offset <= idx.Rows[i-1]) {
+			return fmt.Errorf("Invalid offset %d of row %d", offset, i)
+		}
+	}
+
+	for i, row := range idx.Blocks {
+		if row < 0 || row >= len(idx.Rows) || (i > 0 && row <= idx.Blocks[i-1]) {
+			return fmt.Errorf("Invalid first row %d of 2D slice %d", row, i)
+		}
+	}
+
+	return nil
+}
+
+// Saves RowIndex in a compact binary form
+func (idx *RowIndex) WriteTo(w io.Writer) (int64, error) {
+	buf := []byte(rowIndexMagic)
+	buf = binary.AppendUvarint(buf, uint64(idx.Size))
+	buf = binary.AppendUvarint(buf, uint64(len(idx.Rows)))
+	buf = binary.AppendUvarint(buf, uint64(len(idx.Blocks)))
+	var prevRow int64 = 0
+
+	for _, offset := range idx.Rows {
+		buf = binary.AppendUvarint(buf, uint64(offset-prevRow))
+		prevRow = offset
+	}
+
+	prevBlock := 0
+
+	for _, row := range idx.Blocks {
+		buf = binary.AppendUvarint(buf, uint64(row-prevBlock))
+		prevBlock = row
+	}
+
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+// Constructs new IndexedMatrix with default conversion
+func NewIndexedMatrix[T any](r io.ReaderAt, index *RowIndex) *IndexedMatrix[T] {
+	return &IndexedMatrix[T]{conv: GetConversion[T](), index: index, opts: Options{}, r: r}
+}
+
+// Constructs new IndexedMatrix with options.
+// Separators from options are not supported.
+func NewIndexedMatrixOptions[T any](
+	r io.ReaderAt, index *RowIndex, opts Options,
+	conv func(*ByteReader) (T, uint, error)) (*IndexedMatrix[T], error) {
+
+	if err := checkNoSeparators(opts); err != nil {
+		return nil, err
+	}
+
+	return &IndexedMatrix[T]{conv: conv, index: index, opts: opts, r: r}, nil
+}
+
+// Parses 2D slice at index i
+func (m *IndexedMatrix[T]) Block(i int) ([][]T, error) {
+	return m.BlockContext(context.Background(), i)
+}
+
+// Parses 2D slice at index i until ctx is done
+func (m *IndexedMatrix[T]) BlockContext(ctx context.Context, i int) ([][]T, error) {
+	if i < 0 || i >= m.Blocks() {
+		return nil, fmt.Errorf("2D slice %d out of range [0, %d)", i, m.Blocks())
+	}
+
+	end := m.index.Size
+
+	if i+1 < len(m.index.Blocks) {
+		end = m.index.Rows[m.index.Blocks[i+1]]
+	}
+
+	section, opts := m.section(m.index.Rows[m.index.Blocks[i]], end)
+	return Read2DContext(ctx, section, opts, m.conv)
+}
+
+// Returns number of 2D slices
+func (m *IndexedMatrix[T]) Blocks() int {
+	return len(m.index.Blocks)
+}
+
+// Parses row at index i
+func (m *IndexedMatrix[T]) Row(i int) ([]T, error) {
+	return m.RowContext(context.Background(), i)
+}
+
+// Parses row at index i until ctx is done
+func (m *IndexedMatrix[T]) RowContext(ctx context.Context, i int) ([]T, error) {
+	if i < 0 || i >= m.Rows() {
+		return nil, fmt.Errorf("Row %d out of range [0, %d)", i, m.Rows())
+	}
+
+	end := m.index.Size
+
+	if i+1 < len(m.index.Rows) {
+		end = m.index.Rows[i+1]
+	}
+
+	section, opts := m.section(m.index.Rows[i], end)
+	return Read1DContext(ctx, section, opts, m.conv)
+}
+
+// Returns number of rows
+func (m *IndexedMatrix[T]) Rows() int {
+	return len(m.index.Rows)
+}
+
+// Returns Reader of bytes between offsets start and end
+// and options with buffer no larger than the section
+func (m *IndexedMatrix[T]) section(start, end int64) (io.Reader, Options) {
+	opts := m.opts
+
+	if opts.ChunkSize <= 0 {
+		opts.ChunkSize = int(min(end-start, int64(DefaultChunkSize)))
+	}
+
+	return io.NewSectionReader(m.r, start, end-start), opts
+}
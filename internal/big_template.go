@@ -0,0 +1,178 @@
+package internal
+
+import (
+	"errors"
+	"io"
+	"math/big"
+)
+
+// Number of decimal digits that always fit into uint64
+const bigWordDigits = 19
+
+// Powers of ten that fit into uint64
+var pow10Words = [bigWordDigits + 1]uint64{
+	1, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10,
+	1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19,
+}
+
+// Parts of an arbitrary precision number read by ConvertBigTemplate.
+// The value is (-1)^Negative * Mantissa / 10^Exponent / Denominator.
+type BigParts struct {
+	Denominator *big.Int
+	Exponent    uint
+	Mantissa    *big.Int
+	Negative    bool
+}
+
+// Accumulates decimal digits into a big.Int
+// in chunks of bigWordDigits digits
+type bigAccumulator struct {
+	digits uint
+	res    *big.Int
+	tmp    big.Int
+	word   uint64
+	wordN  int
+}
+
+// Adds one decimal digit
+func (a *bigAccumulator) add(digit uint) {
+	a.word = a.word*10 + uint64(digit)
+	a.wordN++
+	a.digits++
+
+	if a.wordN == bigWordDigits {
+		a.flush()
+	}
+}
+
+// Returns true if no nonzero digit was added
+func (a *bigAccumulator) isZero() bool {
+	return a.word == 0 && a.res.Sign() == 0
+}
+
+// Moves the pending word into the result
+func (a *bigAccumulator) flush() {
+	if a.wordN == 0 {
+		return
+	}
+
+	a.res.Mul(a.res, a.tmp.SetUint64(pow10Words[a.wordN]))
+	a.res.Add(a.res, a.tmp.SetUint64(a.word))
+	a.word, a.wordN = 0, 0
+}
+
+// Template function for converting arbitrary precision numbers.
+// Reads an optional minus sign, digits with an optional decimal dot
+// and, if allowSlash is true, an optional "/denominator" part.
+// Digits are accumulated directly from ByteReader.
+func ConvertBigTemplate(r *ByteReader, allowDot, allowSlash bool) (BigParts, uint, error) {
+	var flags uint = 0
+	mantissa := bigAccumulator{res: new(big.Int)}
+	var denominator *bigAccumulator = nil
+	current := &mantissa
+	parts := BigParts{}
+
+	finish := func(flags uint, err error) (BigParts, uint, error) {
+		if err != nil && err != io.EOF {
+			return BigParts{}, 0, err
+		}
+
+		if (flags&HasValue) == HasValue && mantissa.digits == 0 {
+			return BigParts{}, 0, errors.New("Number without digits")
+		}
+
+		mantissa.flush()
+		parts.Mantissa = mantissa.res
+
+		if denominator != nil {
+			if denominator.digits == 0 {
+				return BigParts{}, 0, errors.New("Missing denominator")
+			}
+
+			denominator.flush()
+			parts.Denominator = denominator.res
+		}
+
+		return parts, flags, err
+	}
+
+	for {
+		b, err := r.NextByteConvertNewline()
+
+		if err != nil {
+			return finish(flags, err)
+		}
+
+		switch {
+		case b >= '0' && b <= '9':
+			if b == '0' && current.isZero() {
+				leading := flags
+
+				if current != &mantissa {
+					leading = 0
+
+					if current.digits > 0 {
+						leading = HasValue
+					}
+				}
+
+				if _, err := ProcessNonDigit(0, leading, uint64(0)); err != nil {
+					return finish(0, err)
+				}
+			}
+
+			current.add(uint(b - '0'))
+			flags |= HasValue
+
+			if (flags&HasDecimals) == HasDecimals && current == &mantissa {
+				parts.Exponent++
+			}
+		case b == '-':
+			if (flags & HasValue) == HasValue {
+				return finish(0, errors.New("Minus sign after digit or dot"))
+			}
+
+			parts.Negative = true
+			flags |= HasValue | IsNegative
+		case b == '.':
+			if !allowDot {
+				return finish(0, errors.New("Decimal dot in big integer"))
+			}
+
+			if (flags & HasDecimals) == HasDecimals {
+				return finish(0, errors.New("Two decimal dots"))
+			}
+
+			if current != &mantissa {
+				return finish(0, errors.New("Decimal dot in denominator"))
+			}
+
+			flags |= HasDecimals | HasValue
+		case b == '/' && allowSlash:
+			if current != &mantissa {
+				return finish(0, errors.New("Two fraction slashes"))
+			}
+
+			if mantissa.digits == 0 {
+				return finish(0, errors.New("Missing numerator"))
+			}
+
+			denominator = &bigAccumulator{res: new(big.Int)}
+			current = denominator
+		case b == '\n':
+			return finish(flags|HasNewline, nil)
+		case b == ' ' || b == '\t' || b == '\r':
+			if (flags & HasValue) == HasValue {
+				return finish(flags, nil)
+			}
+		default:
+			r.MoveBack()
+
+			if (flags & HasValue) == 0 {
+				return finish(0, errors.New("Letter in number"))
+			}
+
+			return finish(flags, nil)
+		}
+	}
+}
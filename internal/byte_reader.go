@@ -1,25 +1,210 @@
 package internal
 
-import "io"
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+)
+
+// Waits for more data at the end of input
+type follower struct {
+	ctx      context.Context
+	interval time.Duration
+}
+
+// Waits for one interval, returns an error if the context is done
+func (f *follower) wait() error {
+	timer := time.NewTimer(f.interval)
+	defer timer.Stop()
+
+	select {
+	case <-f.ctx.Done():
+		return f.ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Position and separator state of a ByteReader
+type ReaderState struct {
+	AfterSep       bool
+	Line           int
+	LineStart      bool
+	Offset         int64
+	PendingNewline bool
+}
 
 // Buffered reader of bytes
 type ByteReader struct {
-	buf    []byte
-	bufLen int
-	impl   io.Reader
-	index  int
+	afterSep       bool
+	base           int64
+	blockSep       byte
+	buf            []byte
+	bufLen         int
+	countLines     bool
+	ctx            context.Context
+	err            error
+	follow         *follower
+	impl           io.Reader
+	index          int
+	lines          int
+	lineStart      bool
+	marker         []byte
+	pendingNewline bool
+	readOnly       bool
+	rowSep         byte
+	window         int
 }
 
-// Constructs new ByteReader
+// Constructs new ByteReader.
+// If chunkSize is not positive, DefaultChunkSize is used.
+// A MemoryReader is parsed directly without a buffer.
+// The context of a ContextReader is checked before each chunk.
 func NewByteReader(r io.Reader, chunkSize int) *ByteReader {
+	if c, ok := r.(*ContextReader); ok {
+		res := NewByteReader(c.impl, chunkSize)
+		res.setContext(c.ctx, chunkSize)
+		return res
+	}
+
+	if m, ok := r.(*MemoryReader); ok {
+		return newMemoryByteReader(m)
+	}
+
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+
 	return &ByteReader{
-		buf:    make([]byte, chunkSize),
-		bufLen: 0,
-		impl:   r,
-		index:  0,
+		afterSep:       false,
+		base:           0,
+		blockSep:       0,
+		buf:            make([]byte, chunkSize),
+		bufLen:         0,
+		countLines:     false,
+		ctx:            nil,
+		err:            nil,
+		follow:         nil,
+		impl:           r,
+		index:          0,
+		lines:          0,
+		lineStart:      true,
+		marker:         nil,
+		pendingNewline: false,
+		readOnly:       false,
+		rowSep:         0,
+		window:         0,
 	}
 }
 
+// Constructs new ByteReader with buffer size and separators from opts
+func NewByteReaderOptions(r io.Reader, opts Options) *ByteReader {
+	res := NewByteReader(r, opts.ChunkSize)
+	res.SetSeparators(opts.RowSeparator, opts.BlockSeparator, opts.BlockMarker)
+
+	if opts.Positions {
+		res.CountLines()
+	}
+
+	return res
+}
+
+// Reads more bytes into the buffer.
+// Unread bytes and the last read byte are moved to the start of the buffer,
+// if there is no space left, the buffer grows.
+func (r *ByteReader) fill() error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if r.ctx != nil {
+		if err := r.ctx.Err(); err != nil {
+			r.err = r.WrapError(err)
+			return r.err
+		}
+	}
+
+	if r.impl == nil {
+		if r.bufLen == len(r.buf) {
+			r.err = io.EOF
+			return r.err
+		}
+
+		r.bufLen = min(len(r.buf), r.bufLen+r.window)
+		return nil
+	}
+
+	if r.follow != nil {
+		if err := r.follow.ctx.Err(); err != nil {
+			return err
+		}
+	}
+
+	keep := r.index
+
+	if keep > 0 {
+		keep--
+	}
+
+	if r.countLines {
+		r.lines += bytes.Count(r.buf[:keep], []byte{'\n'})
+	}
+
+	r.bufLen = copy(r.buf, r.buf[keep:r.bufLen])
+	r.index -= keep
+	r.base += int64(keep)
+
+	if r.bufLen == len(r.buf) {
+		r.buf = append(r.buf, make([]byte, len(r.buf))...)
+	}
+
+	for {
+		n, err := r.impl.Read(r.buf[r.bufLen:])
+		r.bufLen += n
+
+		if err == io.EOF && r.follow != nil {
+			err = nil
+
+			if n == 0 {
+				if err = r.follow.wait(); err != nil {
+					r.err = err
+					return err
+				}
+
+				continue
+			}
+		}
+
+		// The error of a background read is replaced by the done context
+		if err != nil && err != io.EOF && r.ctx != nil && r.ctx.Err() != nil {
+			err = r.WrapError(r.ctx.Err())
+		}
+
+		r.err = err
+
+		if n > 0 {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Returns true if the next byte starts a new line
+func (r *ByteReader) AtLineStart() bool {
+	return r.lineStart && !r.pendingNewline
+}
+
+// Makes the reader wait for more data at the end of input
+// instead of returning io.EOF. The input is checked every interval
+// until ctx is done.
+func (r *ByteReader) Follow(ctx context.Context, interval time.Duration) {
+	r.follow = &follower{ctx: ctx, interval: interval}
+}
+
 // Searches for byte b, if found moves back one character behind b
 func (r *ByteReader) LookAheadFor(b byte) (bool, error) {
 	for {
@@ -48,15 +233,10 @@ func (r *ByteReader) MoveBack() {
 // Returns the next byte without any conversions.
 // If current buffer is exhausted, a new buffer is read.
 func (r *ByteReader) NextByte() (byte, error) {
-	if r.index >= r.bufLen {
-		var err error
-		r.bufLen, err = r.impl.Read(r.buf)
-
-		if err != nil {
+	for r.index >= r.bufLen {
+		if err := r.fill(); err != nil {
 			return 0, err
 		}
-
-		r.index = 0
 	}
 
 	res := r.buf[r.index]
@@ -64,27 +244,54 @@ func (r *ByteReader) NextByte() (byte, error) {
 	return res, nil
 }
 
-// Returns next byte and convert "\r\n" to "\n"
+// Returns next byte and convert "\r\n" to "\n".
+// Separators set by SetSeparators are converted to newlines.
 func (r *ByteReader) NextByteConvertNewline() (byte, error) {
-	b, err := r.NextByte()
+	if r.pendingNewline {
+		r.pendingNewline = false
+		return '\n', nil
+	}
 
-	if err != nil {
-		return 0, err
+	if r.lineStart && r.marker != nil && r.SkipLine(r.marker) {
+		return '\n', nil
 	}
 
-	if b == '\r' {
-		if nb, err := r.NextByte(); err != nil {
+	for {
+		b, err := r.NextByte()
+
+		if err != nil {
 			return 0, err
-		} else if nb == '\n' {
-			return '\n', nil
 		}
 
-		r.MoveBack()
-	} else if b == '\n' {
-		return '\n', nil
-	}
+		if b == '\r' {
+			if nb, err := r.NextByte(); err != nil {
+				return 0, err
+			} else if nb == '\n' {
+				b = '\n'
+			} else {
+				r.MoveBack()
+			}
+		}
+
+		if b == '\n' && r.afterSep {
+			r.afterSep = false
+			continue
+		}
+
+		if b != ' ' && b != '\t' {
+			r.afterSep = false
+		}
+
+		// A block separator at the start of a line only adds the empty line
+		if b != 0 && (b == r.rowSep || b == r.blockSep) {
+			r.afterSep = true
+			r.pendingNewline = b == r.blockSep && !r.lineStart
+			b = '\n'
+		}
 
-	return b, nil
+		r.lineStart = b == '\n'
+		return b, nil
+	}
 }
 
 // Returns next number symbol as uint
@@ -118,6 +325,140 @@ func (r *ByteReader) NextDigit() (uint, error) {
 	return Letter, nil
 }
 
+// Appends the next whitespace separated token to buf.
+// Leading spaces and tabs are skipped, the whitespace after the token is consumed.
+// Returned flags contain HasValue if a token was found
+// and HasNewline if the token was terminated by a newline.
+func (r *ByteReader) NextToken(buf []byte) ([]byte, uint, error) {
+	var flags uint = 0
+
+	for {
+		b, err := r.NextByteConvertNewline()
+
+		if err != nil {
+			return buf, flags, err
+		}
+
+		if b == '\n' {
+			return buf, flags | HasNewline, nil
+		}
+
+		if b == ' ' || b == '\t' || b == '\r' {
+			if (flags & HasValue) == HasValue {
+				return buf, flags, nil
+			}
+
+			continue
+		}
+
+		buf = append(buf, b)
+		flags |= HasValue
+	}
+}
+
+// Counts newlines of each chunk before it is discarded,
+// so that State and WrapError return correct line numbers
+func (r *ByteReader) CountLines() {
+	r.countLines = true
+}
+
+// Reads from impl from the start, the buffer and separators are kept
+func (r *ByteReader) Reset(impl io.Reader) {
+	if r.impl == nil {
+		r.buf = make([]byte, DefaultChunkSize)
+		r.readOnly = false
+	}
+
+	r.ctx = nil
+
+	if c, ok := impl.(*ContextReader); ok {
+		impl, r.ctx = c.impl, c.ctx
+		r.countLines = true
+	}
+
+	r.afterSep = false
+	r.base = 0
+	r.bufLen = 0
+	r.err = nil
+	r.follow = nil
+	r.impl = impl
+	r.index = 0
+	r.lines = 0
+	r.lineStart = true
+	r.pendingNewline = false
+}
+
+// Restores state returned by State.
+// The underlying Reader must be positioned at state.Offset.
+func (r *ByteReader) Restore(state ReaderState) {
+	r.afterSep = state.AfterSep
+	r.base = state.Offset
+	r.bufLen = 0
+	r.err = nil
+	r.index = 0
+	r.lines = max(state.Line-1, 0)
+	r.lineStart = state.LineStart
+	r.pendingNewline = state.PendingNewline
+}
+
+// Sets bytes converted to a newline and to an empty line
+// and a line converted to an empty line.
+// Zero bytes and an empty marker are not used.
+// Physical newlines after a separator are ignored.
+func (r *ByteReader) SetSeparators(rowSep, blockSep byte, marker string) {
+	r.rowSep = rowSep
+	r.blockSep = blockSep
+	r.marker = nil
+
+	if marker != "" {
+		r.marker = []byte(marker)
+	}
+}
+
+// Skips the next line if it consists only of line and trailing whitespace
+func (r *ByteReader) SkipLine(line []byte) bool {
+	n := len(line)
+
+	for i := 0; ; i++ {
+		for r.index+i >= r.bufLen {
+			if err := r.fill(); err != nil {
+				if i < n {
+					return false
+				}
+
+				r.index += i
+				return true
+			}
+		}
+
+		b := r.buf[r.index+i]
+
+		if i < n {
+			if b != line[i] {
+				return false
+			}
+		} else if b == '\n' {
+			r.index += i + 1
+			return true
+		} else if b != ' ' && b != '\t' && b != '\r' {
+			return false
+		}
+	}
+}
+
+// Skips the next n lines without any conversions
+func (r *ByteReader) SkipLines(n int) error {
+	for ; n > 0; n-- {
+		if err := r.SkipByte('\n'); err != nil {
+			return err
+		}
+
+		r.lineStart = true
+	}
+
+	return nil
+}
+
 // Skip the next byte b, if found
 func (r *ByteReader) SkipByte(b byte) error {
 	for {
@@ -132,3 +473,16 @@ func (r *ByteReader) SkipByte(b byte) error {
 		}
 	}
 }
+
+// Returns line number and offset of the next byte and separator state.
+// Lines are numbered from 1. The line number is correct only for
+// in-memory inputs or if newlines are counted since CountLines.
+func (r *ByteReader) State() ReaderState {
+	return ReaderState{
+		AfterSep:       r.afterSep,
+		Line:           r.lines + bytes.Count(r.buf[:r.index], []byte{'\n'}) + 1,
+		LineStart:      r.lineStart,
+		Offset:         r.base + int64(r.index),
+		PendingNewline: r.pendingNewline,
+	}
+}
@@ -0,0 +1,69 @@
+package internal
+
+import (
+	"context"
+	"io"
+)
+
+// Reader that makes ByteReader check a context before reading each chunk
+type ContextReader struct {
+	ctx  context.Context
+	impl io.Reader
+}
+
+// Constructs new ContextReader of r
+func NewContextReader(ctx context.Context, r io.Reader) *ContextReader {
+	return &ContextReader{ctx: ctx, impl: r}
+}
+
+// ReaderAt that returns the error of a context when it is done
+type contextReaderAt struct {
+	ctx  context.Context
+	impl io.ReaderAt
+}
+
+// Returns ReaderAt that checks ctx before each read of r.
+// Returns r if ctx can never be done.
+func NewContextReaderAt(ctx context.Context, r io.ReaderAt) io.ReaderAt {
+	if ctx.Done() == nil {
+		return r
+	}
+
+	return contextReaderAt{ctx: ctx, impl: r}
+}
+
+// Returns the error of the context if it is done, otherwise reads from r
+func (c contextReaderAt) ReadAt(b []byte, off int64) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return c.impl.ReadAt(b, off)
+}
+
+// Returns the error of the context if it is done, otherwise reads from r
+func (c *ContextReader) Read(b []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return c.impl.Read(b)
+}
+
+// Makes ByteReader check ctx before reading each chunk
+// and count lines for the position of its error.
+// Bytes in memory are made available in windows of chunkSize bytes,
+// so that ctx is checked even if no reading is needed.
+func (r *ByteReader) setContext(ctx context.Context, chunkSize int) {
+	r.ctx = ctx
+	r.countLines = true
+
+	if r.impl == nil {
+		if chunkSize <= 0 {
+			chunkSize = DefaultChunkSize
+		}
+
+		r.bufLen = r.index
+		r.window = chunkSize
+	}
+}
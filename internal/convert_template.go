@@ -5,7 +5,16 @@ func ConvertSignedTemplate[T SignedNumber](r *ByteReader,
 	processNonDigit func(uint, uint, T) (uint, error),
 	processDigit func(uint, uint, T) (T, uint),
 ) (T, uint, error) {
-	res, flags, err := ConvertTemplate(r, processNonDigit, processDigit)
+	return ConvertSignedTemplateFrom(r, T(0), 0, processNonDigit, processDigit)
+}
+
+// Template function for converting floats and integers
+// that continues from a partial result and its flags
+func ConvertSignedTemplateFrom[T SignedNumber](r *ByteReader, res T, flags uint,
+	processNonDigit func(uint, uint, T) (uint, error),
+	processDigit func(uint, uint, T) (T, uint),
+) (T, uint, error) {
+	res, flags, err := ConvertTemplateFrom(r, res, flags, processNonDigit, processDigit)
 
 	if (flags & IsNegative) == IsNegative {
 		res *= T(-1)
@@ -19,11 +28,19 @@ func ConvertTemplate[T Number](
 	r *ByteReader,
 	processNonDigit func(uint, uint, T) (uint, error),
 	processDigit func(uint, uint, T) (T, uint),
+) (T, uint, error) {
+	return ConvertTemplateFrom(r, T(0), 0, processNonDigit, processDigit)
+}
+
+// Template function for converting all number types
+// that continues from a partial result and its flags
+func ConvertTemplateFrom[T Number](
+	r *ByteReader, res T, flags uint,
+	processNonDigit func(uint, uint, T) (uint, error),
+	processDigit func(uint, uint, T) (T, uint),
 ) (T, uint, error) {
 	var digit uint = 0
 	var err error = nil
-	var flags uint = 0
-	res := T(0)
 
 	for {
 		digit, err = r.NextDigit()
@@ -0,0 +1,106 @@
+package internal
+
+import "io"
+
+// Counts values of rows and rows of 2D slices that byteReader would read
+// with opts, without moving byteReader. Values are whitespace separated tokens.
+// Returns nil slices if the input is neither in memory nor seekable.
+func CountSizes(byteReader *ByteReader, opts Options) ([]int, []int, error) {
+	if byteReader.impl == nil {
+		clone := *byteReader
+		return countSizes(&clone, opts)
+	}
+
+	seeker, ok := byteReader.impl.(io.Seeker)
+
+	if !ok || byteReader.follow != nil {
+		return nil, nil, nil
+	}
+
+	end, err := seeker.Seek(0, io.SeekCurrent)
+
+	if err != nil {
+		return nil, nil, nil
+	}
+
+	start := end - int64(byteReader.bufLen-byteReader.index)
+
+	if _, err = seeker.Seek(start, io.SeekStart); err != nil {
+		return nil, nil, err
+	}
+
+	counter := NewByteReaderOptions(byteReader.impl, opts)
+	counter.ctx = byteReader.ctx
+	counter.Restore(byteReader.State())
+	rows, blocks, countErr := countSizes(counter, opts)
+
+	if _, err = seeker.Seek(end, io.SeekStart); err != nil {
+		return nil, nil, err
+	}
+
+	return rows, blocks, countErr
+}
+
+// Internal implementation of CountSizes
+func countSizes(r *ByteReader, opts Options) ([]int, []int, error) {
+	if err := r.SkipLines(opts.SkipLines); err != nil && err != io.EOF {
+		return nil, nil, err
+	}
+
+	blocks := make([]int, 0)
+	rows := make([]int, 0)
+	sentinel := []byte(opts.Sentinel)
+	var buf []byte
+	values, blockRows := 0, 0
+
+	for {
+		if len(sentinel) > 0 && r.AtLineStart() && r.SkipLine(sentinel) {
+			break
+		}
+
+		count, newline := r.ScanLine()
+		values += count
+
+		if !newline {
+			token, flags, err := r.NextTokenView(buf[:0])
+
+			if r.impl != nil {
+				buf = token
+			}
+
+			if (flags & HasValue) == HasValue {
+				values++
+			}
+
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, nil, err
+			}
+
+			if (flags & HasNewline) == 0 {
+				continue
+			}
+		}
+
+		if values > 0 {
+			rows = append(rows, values)
+			values = 0
+			blockRows++
+		} else if blockRows > 0 {
+			blocks = append(blocks, blockRows)
+			blockRows = 0
+		}
+	}
+
+	if values > 0 {
+		rows = append(rows, values)
+		blockRows++
+	}
+
+	if blockRows > 0 {
+		blocks = append(blocks, blockRows)
+	}
+
+	return rows, blocks, nil
+}
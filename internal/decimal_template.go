@@ -0,0 +1,125 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Maximum number of decimal places of a fixed-point decimal
+const MaxDecimalScale uint8 = 18
+
+// Template function for converting fixed-point decimals.
+// Reads at most maxScale decimal places, additional trailing zeros are ignored.
+// If fixed is true, the mantissa is scaled to exactly maxScale decimal places.
+func ConvertDecimalTemplate(
+	r *ByteReader, maxScale uint8, fixed bool,
+) (int64, uint8, uint, error) {
+	var flags uint = 0
+	var magnitude uint64 = 0
+	var scale uint8 = 0
+	hasDigits := false
+
+	for {
+		digit, err := r.NextDigit()
+
+		if digit == Letter {
+			r.MoveBack()
+		}
+
+		if err != nil {
+			return finishDecimal(magnitude, scale, maxScale, fixed, hasDigits, flags, err)
+		}
+
+		switch digit {
+		case DecimalDot:
+			if (flags & HasDecimals) == HasDecimals {
+				return 0, 0, 0, errors.New("Two decimal dots")
+			}
+
+			flags |= HasDecimals | HasValue
+			continue
+		case MinusSign:
+			if flags, err = ProcessSignedNonDigit(digit, flags, 0); err != nil {
+				return 0, 0, 0, err
+			}
+
+			continue
+		case Newline, WhiteSpace, Letter:
+			if flags, err = ProcessNonDigit(digit, flags, 0); err != nil {
+				return 0, 0, 0, err
+			}
+
+			if (flags & Break) == Break {
+				return finishDecimal(magnitude, scale, maxScale, fixed, hasDigits, flags, nil)
+			}
+
+			continue
+		}
+
+		if flags, err = ProcessNonDigit(digit, flags, magnitude); err != nil {
+			return 0, 0, 0, err
+		}
+
+		flags |= HasValue
+		hasDigits = true
+
+		if (flags & HasDecimals) == HasDecimals {
+			if scale == maxScale {
+				if digit != 0 {
+					return 0, 0, 0, fmt.Errorf(
+						"Decimal needs more than %d decimal places", maxScale)
+				}
+
+				continue
+			}
+
+			scale++
+		}
+
+		if magnitude, err = appendDecimalDigit(magnitude, uint64(digit)); err != nil {
+			return 0, 0, 0, err
+		}
+	}
+}
+
+// Appends a digit to the magnitude of a decimal
+func appendDecimalDigit(magnitude, digit uint64) (uint64, error) {
+	if magnitude > (math.MaxInt64+1-digit)/10 {
+		return 0, errors.New("Decimal out of range")
+	}
+
+	return magnitude*10 + digit, nil
+}
+
+// Applies sign and scale to the result of ConvertDecimalTemplate.
+// A sign or a dot without digits is an error.
+func finishDecimal(
+	magnitude uint64, scale, maxScale uint8, fixed, hasDigits bool, flags uint, err error,
+) (int64, uint8, uint, error) {
+	if (flags&HasValue) == HasValue && !hasDigits {
+		return 0, 0, 0, errors.New("Number without digits")
+	}
+
+	if fixed {
+		var scaleErr error
+
+		for ; scale < maxScale && scaleErr == nil; scale++ {
+			magnitude, scaleErr = appendDecimalDigit(magnitude, 0)
+		}
+
+		if scaleErr != nil {
+			return 0, 0, 0, scaleErr
+		}
+	}
+
+	if (flags & IsNegative) == IsNegative {
+		return -int64(magnitude), scale, flags, err
+	}
+
+	if magnitude > math.MaxInt64 {
+		return 0, 0, 0, errors.New("Decimal out of range")
+	}
+
+	return int64(magnitude), scale, flags, err
+}
@@ -0,0 +1,96 @@
+package internal
+
+import "io"
+
+// Bytes in memory that ByteReader parses without copying
+type MemoryReader struct {
+	data     []byte
+	offset   int
+	readOnly bool
+}
+
+// Constructs new MemoryReader of data.
+// If readOnly is true, data is never exposed to the caller as a view.
+func NewMemoryReader(data []byte, readOnly bool) *MemoryReader {
+	return &MemoryReader{data: data, offset: 0, readOnly: readOnly}
+}
+
+// Copies unread bytes to b like any other Reader
+func (m *MemoryReader) Read(b []byte) (int, error) {
+	if m.offset >= len(m.data) {
+		return 0, io.EOF
+	}
+
+	n := copy(b, m.data[m.offset:])
+	m.offset += n
+	return n, nil
+}
+
+// Constructs new ByteReader that uses unread bytes of m as its buffer
+func newMemoryByteReader(m *MemoryReader) *ByteReader {
+	res := NewByteReader(nil, 1)
+	res.base = int64(m.offset)
+	res.buf = m.data[m.offset:len(m.data):len(m.data)]
+	res.bufLen = len(res.buf)
+	res.readOnly = m.readOnly
+	m.offset = len(m.data)
+	return res
+}
+
+// Returns the next whitespace separated token like NextToken.
+// If ByteReader reads from memory, the token is a view of the memory,
+// otherwise the token is appended to buf.
+func (r *ByteReader) NextTokenView(buf []byte) ([]byte, uint, error) {
+	if r.impl != nil {
+		return r.NextToken(buf)
+	}
+
+	var err error
+	var flags uint = 0
+	start, end := r.index, r.index
+
+	for {
+		var b byte
+
+		if b, err = r.NextByteConvertNewline(); err != nil {
+			break
+		}
+
+		if b == '\n' {
+			flags |= HasNewline
+			break
+		}
+
+		if b == ' ' || b == '\t' || b == '\r' {
+			if (flags & HasValue) == HasValue {
+				break
+			}
+
+			continue
+		}
+
+		if (flags & HasValue) == 0 {
+			start = r.index - 1
+		}
+
+		end = r.index
+		flags |= HasValue
+	}
+
+	if (flags & HasValue) == 0 {
+		return nil, flags, err
+	}
+
+	return r.buf[start:end:end], flags, err
+}
+
+// Returns the next whitespace separated token that the caller may keep.
+// The token is a view of the memory if ByteReader reads from memory
+// that is not read-only, otherwise it is a copy.
+func (r *ByteReader) NextTokenBytes() ([]byte, uint, error) {
+	if r.readOnly {
+		return r.NextToken(nil)
+	}
+
+	return r.NextTokenView(nil)
+}
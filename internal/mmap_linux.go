@@ -0,0 +1,42 @@
+//go:build linux
+
+package internal
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// Maps a regular file read-only into memory.
+// Returns the mapped bytes and a function that releases the mapping.
+func MapFile(file *os.File) ([]byte, func() error, error) {
+	info, err := file.Stat()
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !info.Mode().IsRegular() {
+		return nil, nil, errors.New("Only regular files can be mapped")
+	}
+
+	size := info.Size()
+
+	if size == 0 {
+		return []byte{}, func() error { return nil }, nil
+	}
+
+	if size != int64(int(size)) {
+		return nil, nil, errors.New("File is too large to be mapped")
+	}
+
+	data, err := syscall.Mmap(
+		int(file.Fd()), 0, int(size), syscall.PROT_READ, syscall.MAP_SHARED)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, func() error { return syscall.Munmap(data) }, nil
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+
+package internal
+
+import (
+	"errors"
+	"os"
+)
+
+// Memory mapping is not supported on this platform, returns an error
+func MapFile(file *os.File) ([]byte, func() error, error) {
+	return nil, nil, errors.New("Memory mapping is not supported")
+}
@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// Default buffer size for ByteReader
+	DefaultChunkSize int = 32768
+)
+
+// Options of reading functions
+type Options struct {
+	// Line that ends a 2D slice like an empty line, not used if empty
+	BlockMarker string
+	// Byte that ends a 2D slice like an empty line, not used if zero
+	BlockSeparator byte
+	// Buffer size of ByteReader, DefaultChunkSize if not positive
+	ChunkSize int
+	// Expected number of values of a row or of a 1D slice,
+	// used as initial capacity
+	ColsHint int
+	// Maximum number of elements of the outermost dimension:
+	// values of 1D slices, rows of 2D slices and 2D slices of 3D slices.
+	// Not used if not positive.
+	Limit int
+	// Return conversion errors as PositionError with line and offset.
+	// Newlines of each chunk read from a stream are counted,
+	// in-memory inputs are counted only when an error occurs.
+	Positions bool
+	// Count values of each row in a first pass over a seekable
+	// or in-memory input, so that each slice is allocated once
+	Preallocate bool
+	// Read the next chunk of input in a background goroutine
+	// while the current chunk is being parsed.
+	// Reading functions return only after the goroutine stops,
+	// so they wait for a Read of the input that is in progress.
+	// Bytes read ahead are discarded, so Limit and Sentinel,
+	// which leave the rest of the input unread, are not supported.
+	Prefetch bool
+	// Keep empty rows and empty 2D slices as empty slices.
+	// In 2D slices, each line is a row.
+	// In 3D slices, each empty line ends a 2D slice,
+	// so n empty lines between rows produce n-1 empty 2D slices
+	// and n empty lines at the start produce n empty 2D slices.
+	// Empty lines at the end of the input are ignored.
+	PreserveEmpty bool
+	// Byte that ends a row like a newline, not used if zero
+	RowSeparator byte
+	// Expected number of rows of a 2D slice, used as initial capacity
+	RowsHint int
+	// Line that stops reading, not used if empty.
+	// The line is consumed, bytes after it are not read.
+	Sentinel string
+	// Number of leading lines skipped without parsing
+	SkipLines int
+}
+
+// Returns an error if the options are inconsistent
+func (o *Options) Validate() error {
+	for _, sep := range []byte{o.RowSeparator, o.BlockSeparator} {
+		if (sep >= '0' && sep <= '9') || sep == '-' || sep == '.' ||
+			sep == ' ' || sep == '\t' || sep == '\r' || sep == '\n' {
+			return fmt.Errorf("Separator %q is a digit, sign, dot or whitespace", sep)
+		}
+	}
+
+	if o.Prefetch && (o.Limit > 0 || o.Sentinel != "") {
+		return errors.New("Prefetch cannot be used with Limit or Sentinel")
+	}
+
+	if o.RowSeparator != 0 && o.RowSeparator == o.BlockSeparator {
+		return fmt.Errorf("Row and block separators are both %q", o.RowSeparator)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+)
+
+// Returns offsets that split the section of r from start to size
+// into at most parts sections of whole lines.
+// The first offset is start and the last offset is size.
+func SplitLines(r io.ReaderAt, start, size int64, parts int) ([]int64, error) {
+	res := []int64{start}
+	buf := make([]byte, 4096)
+
+	for i := 1; i < parts; i++ {
+		pos := start + (size-start)*int64(i)/int64(parts)
+		pos = max(pos, res[len(res)-1])
+
+		for pos < size {
+			n, err := r.ReadAt(buf[:min(int64(len(buf)), size-pos)], pos)
+
+			if j := bytes.IndexByte(buf[:n], '\n'); j >= 0 {
+				pos += int64(j) + 1
+				break
+			}
+
+			pos += int64(n)
+
+			if err == io.EOF {
+				pos = size
+			} else if err != nil {
+				return nil, err
+			}
+		}
+
+		if pos < size && pos > res[len(res)-1] {
+			res = append(res, pos)
+		}
+	}
+
+	return append(res, size), nil
+}
+
+// Reads 2D slice from sections of r between bounds in parallel
+// until ctx is done. firstLine is the line number of the first section.
+// Rows must not span lines, results and errors are the same
+// as if all sections were read by one SliceReader.
+func RunParallel2D[T any](
+	ctx context.Context, r io.ReaderAt, bounds []int64, firstLine int, opts Options,
+	conv func(*ByteReader) (T, uint, error),
+) ([][]T, error) {
+	if conv == nil {
+		return nil, errors.New("Conversion function is nil")
+	}
+
+	parts := len(bounds) - 1
+	errs := make([]error, parts)
+	lines := make([]int, parts)
+	results := make([][][]T, parts)
+	opts.SkipLines = 0
+	var wg sync.WaitGroup
+
+	for i := 0; i < parts; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+			section := io.NewSectionReader(r, bounds[i], bounds[i+1]-bounds[i])
+			byteReader := NewByteReaderOptions(NewContextReader(ctx, section), opts)
+			byteReader.Restore(ReaderState{Line: 1, LineStart: true, Offset: bounds[i]})
+
+			sliceReader := NewSliceReader(byteReader, conv, 2, opts)
+			errs[i] = sliceReader.Run()
+			lines[i] = byteReader.State().Line - 1
+			results[i] = sliceReader.Buf2
+		}(i)
+	}
+
+	wg.Wait()
+	rows := 0
+
+	for i, err := range errs {
+		if err != nil {
+			var posErr *PositionError
+
+			if errors.As(err, &posErr) {
+				posErr.Line += firstLine - 1
+			}
+
+			return nil, err
+		}
+
+		firstLine += lines[i]
+		rows += len(results[i])
+	}
+
+	res := make([][]T, 0, rows)
+
+	for _, part := range results {
+		res = append(res, part...)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,37 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Error with the position in the input where it occurred
+type PositionError struct {
+	Err error
+	// Line number counted from 1
+	Line int
+	// Offset of the first byte that was not read
+	Offset int64
+}
+
+func (e *PositionError) Error() string {
+	return fmt.Sprintf("Line %d (offset %d): %v", e.Line, e.Offset, e.Err)
+}
+
+func (e *PositionError) Unwrap() error {
+	return e.Err
+}
+
+// Wraps err in PositionError with the current position.
+// Returns nil, io.EOF and errors that already have a position unchanged.
+func (r *ByteReader) WrapError(err error) error {
+	var posErr *PositionError
+
+	if err == nil || err == io.EOF || errors.As(err, &posErr) {
+		return err
+	}
+
+	state := r.State()
+	return &PositionError{Err: err, Line: state.Line, Offset: state.Offset}
+}
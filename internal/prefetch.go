@@ -0,0 +1,139 @@
+package internal
+
+import (
+	"context"
+	"io"
+)
+
+// Chunk of bytes read in the background
+type prefetchChunk struct {
+	data []byte
+	err  error
+}
+
+// Reader that reads the next chunk of the underlying Reader
+// in a background goroutine while the current chunk is being parsed
+type prefetcher struct {
+	chunks chan prefetchChunk
+	ctx    context.Context
+	cur    []byte
+	done   chan struct{}
+	err    error
+	free   chan []byte
+	held   []byte
+}
+
+// Constructs new ByteReader that reads from r in a background goroutine.
+// Two buffers of chunkSize bytes are used, one is parsed while the other is filled.
+// The goroutine stops at the end of input, after an error of r
+// or when ctx is done. Cancel ctx to stop it before the end of input.
+// A MemoryReader is parsed directly without a goroutine.
+func NewPrefetchByteReader(ctx context.Context, r io.Reader, chunkSize int) *ByteReader {
+	res, _ := newPrefetchByteReader(ctx, r, Options{ChunkSize: chunkSize})
+	return res
+}
+
+// Internal implementation of NewPrefetchByteReader with options.
+// Also returns a channel that is closed when the goroutine stops
+// or nil if no goroutine was started.
+func newPrefetchByteReader(
+	ctx context.Context, r io.Reader, opts Options,
+) (*ByteReader, <-chan struct{}) {
+	var readerCtx context.Context = nil
+	inner := r
+
+	if c, ok := r.(*ContextReader); ok {
+		inner, readerCtx = c.impl, c.ctx
+	}
+
+	if _, ok := inner.(*MemoryReader); ok {
+		return NewByteReaderOptions(r, opts), nil
+	}
+
+	chunkSize := opts.ChunkSize
+
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+
+	p := &prefetcher{
+		chunks: make(chan prefetchChunk, 1),
+		ctx:    ctx,
+		cur:    nil,
+		done:   make(chan struct{}),
+		err:    nil,
+		free:   make(chan []byte, 2),
+		held:   nil,
+	}
+
+	p.free <- make([]byte, chunkSize)
+	p.free <- make([]byte, chunkSize)
+	go p.run(r)
+	res := NewByteReaderOptions(p, opts)
+
+	// The error of the context of a ContextReader is returned with a position
+	if readerCtx != nil {
+		res.setContext(readerCtx, chunkSize)
+	}
+
+	return res, p.done
+}
+
+// Reads chunks of r until the end of input, an error or until ctx is done.
+// A Read of r that already started is not interrupted by ctx.
+func (p *prefetcher) run(r io.Reader) {
+	defer close(p.done)
+
+	for {
+		var buf []byte
+
+		select {
+		case buf = <-p.free:
+		case <-p.ctx.Done():
+			return
+		}
+
+		n, err := r.Read(buf)
+
+		select {
+		case p.chunks <- prefetchChunk{data: buf[:n], err: err}:
+		case <-p.ctx.Done():
+			return
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+// Copies bytes of the current chunk to b.
+// The error of a chunk is returned after all its bytes.
+func (p *prefetcher) Read(b []byte) (int, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	for len(p.cur) == 0 {
+		if p.err != nil {
+			return 0, p.err
+		}
+
+		if p.held != nil {
+			p.free <- p.held[:cap(p.held)]
+			p.held = nil
+		}
+
+		select {
+		case chunk := <-p.chunks:
+			p.cur, p.err, p.held = chunk.data, chunk.err, chunk.data
+		case <-p.ctx.Done():
+			p.err = p.ctx.Err()
+			return 0, p.err
+		}
+	}
+
+	n := copy(b, p.cur)
+	p.cur = p.cur[n:]
+	return n, nil
+}
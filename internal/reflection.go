@@ -2,6 +2,10 @@ package internal
 
 import r "reflect"
 
+// Whitespace separated token as bytes.
+// Unlike []byte, Token is an element and not a dimension.
+type Token []byte
+
 // Result of counting number of dimensions of a generic type
 type DescendInfo struct {
 	Dimensions  uint
@@ -20,7 +24,7 @@ func DynamicDescend(aType r.Type) DescendInfo {
 	var dims uint = 0
 	kind := aType.Kind()
 
-	for kind == r.Slice {
+	for kind == r.Slice && aType != GetType[Token]() {
 		aType = aType.Elem()
 		kind = aType.Kind()
 		dims++
@@ -0,0 +1,63 @@
+package internal
+
+import "io"
+
+// Scans r once and returns byte offsets of lines that are not empty,
+// indices of rows that start 2D slices separated by empty lines
+// and the total number of bytes
+func ScanRowOffsets(r io.Reader, chunkSize int) ([]int64, []int, int64, error) {
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+
+	buf := make([]byte, chunkSize)
+	rows := make([]int64, 0)
+	blocks := make([]int, 0)
+	hasContent := false
+	newBlock := true
+	var lineStart, offset int64 = 0, 0
+
+	addLine := func() {
+		if !hasContent {
+			newBlock = true
+			return
+		}
+
+		if newBlock {
+			blocks = append(blocks, len(rows))
+			newBlock = false
+		}
+
+		rows = append(rows, lineStart)
+		hasContent = false
+	}
+
+	for {
+		n, err := r.Read(buf)
+
+		for i, b := range buf[:n] {
+			switch b {
+			case '\n':
+				addLine()
+				lineStart = offset + int64(i) + 1
+			case ' ', '\t', '\r':
+			default:
+				hasContent = true
+			}
+		}
+
+		offset += int64(n)
+
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, nil, 0, err
+		}
+	}
+
+	if hasContent {
+		addLine()
+	}
+
+	return rows, blocks, offset, nil
+}
@@ -0,0 +1,156 @@
+package internal
+
+import "golang.org/x/exp/constraints"
+
+// Returns true if bytes of the buffer can be scanned without conversions,
+// that is no separator, marker or pending newline has to be processed
+func (r *ByteReader) canScan() bool {
+	return !r.afterSep && !r.pendingNewline && (r.marker == nil || !r.lineStart)
+}
+
+// Consumes n scanned bytes of the buffer, newline is true if the last one was '\n'
+func (r *ByteReader) consume(n int, newline bool) {
+	if n > 0 {
+		r.index += n
+		r.lineStart = newline
+	}
+}
+
+// Scans an integer directly over the buffered bytes.
+// Stops before the end of the buffer and before any byte
+// that needs conversions or is an error, the rest of the number
+// is then read by ConvertTemplateFrom from the returned result and flags.
+// Returns true if the number was read completely.
+func ScanInteger[T constraints.Integer](r *ByteReader, signed bool) (T, uint, bool) {
+	var flags uint = 0
+	res := T(0)
+
+	if !r.canScan() {
+		return res, flags, false
+	}
+
+	buf := r.buf[r.index:r.bufLen]
+
+	for i, b := range buf {
+		if d := b - '0'; d <= 9 {
+			if d == 0 && res == 0 && (flags&HasValue) == HasValue {
+				r.consume(i, false)
+				return res, flags, false
+			}
+
+			res = res*T(10) + T(d)
+			flags |= HasValue
+			continue
+		}
+
+		switch {
+		case b == ' ' || b == '\t':
+			if (flags & HasValue) == HasValue {
+				r.consume(i+1, false)
+				return res, flags | Break, true
+			}
+		case b == '\n':
+			r.consume(i+1, true)
+			return res, flags | HasNewline | Break, true
+		case b == '-' && signed && (flags&HasValue) == 0:
+			flags |= HasValue | IsNegative
+		default:
+			r.consume(i, false)
+			return res, flags, false
+		}
+	}
+
+	r.consume(len(buf), false)
+	return res, flags, false
+}
+
+// Scans a float directly over the buffered bytes like ScanInteger.
+// decMult is the value of the next decimal place.
+func ScanFloat[T constraints.Float](r *ByteReader, decMult *T) (T, uint, bool) {
+	var flags uint = 0
+	res := T(0)
+
+	if !r.canScan() {
+		return res, flags, false
+	}
+
+	buf := r.buf[r.index:r.bufLen]
+
+	for i, b := range buf {
+		if d := b - '0'; d <= 9 {
+			if (flags & HasDecimals) == HasDecimals {
+				res += *decMult * T(d)
+				*decMult *= T(.1)
+			} else if d == 0 && res == 0 && (flags&HasValue) == HasValue {
+				r.consume(i, false)
+				return res, flags, false
+			} else {
+				res = res*T(10.) + T(d)
+			}
+
+			flags |= HasValue
+			continue
+		}
+
+		switch {
+		case b == ' ' || b == '\t':
+			if (flags & HasValue) == HasValue {
+				r.consume(i+1, false)
+				return res, flags | Break, true
+			}
+		case b == '\n':
+			r.consume(i+1, true)
+			return res, flags | HasNewline | Break, true
+		case b == '-' && (flags&HasValue) == 0:
+			flags |= HasValue | IsNegative
+		case b == '.' && (flags&HasDecimals) == 0:
+			flags |= HasDecimals | HasValue
+		default:
+			r.consume(i, false)
+			return res, flags, false
+		}
+	}
+
+	r.consume(len(buf), false)
+	return res, flags, false
+}
+
+// Counts whitespace separated tokens directly over the buffered bytes
+// until a newline, which is consumed.
+// Stops before the end of the buffer, a token that is not complete
+// and any byte that needs conversions.
+// Returns the number of tokens and true if a newline was reached.
+func (r *ByteReader) ScanLine() (int, bool) {
+	if !r.canScan() {
+		return 0, false
+	}
+
+	buf := r.buf[r.index:r.bufLen]
+	count, start := 0, -1
+
+	for i, b := range buf {
+		switch {
+		case b == ' ' || b == '\t':
+			start = -1
+		case b == '\n':
+			r.consume(i+1, true)
+			return count, true
+		case b == '\r' || (b != 0 && (b == r.rowSep || b == r.blockSep)):
+			r.consume(i, false)
+			return count, false
+		default:
+			if start < 0 {
+				start = i
+				count++
+			}
+		}
+	}
+
+	if start >= 0 {
+		r.consume(start, false)
+		return count - 1, false
+	}
+
+	r.consume(len(buf), false)
+	return count, false
+}
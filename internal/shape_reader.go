@@ -0,0 +1,105 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+	"math"
+)
+
+// Length of a dimension that is read from the input
+const ShapeFromInput int = -1
+
+// Maximum number of slices of a shape without elements.
+// Such slices are not backed by any input, so a wrong count
+// must not allocate too much.
+const MaxEmptySlices int = 1 << 20
+
+// Reads one count token
+func readCount(r *ByteReader) (int, error) {
+	for {
+		count, flags, err := ConvertTemplate[uint](r, ProcessUintNonDigit, ProcessDigit)
+
+		if (flags & HasValue) == HasValue {
+			if err != nil && err != io.EOF {
+				return 0, err
+			}
+
+			if count > math.MaxInt {
+				return 0, fmt.Errorf("Count %d is too large", count)
+			}
+
+			return int(count), nil
+		}
+
+		if err == io.EOF {
+			return 0, io.ErrUnexpectedEOF
+		}
+
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+// Reads exactly n elements of type T regardless of newlines.
+// Returns an error if the input has less or more than n elements.
+func ReadFlat[T any](
+	r *ByteReader, conv func(*ByteReader) (T, uint, error), n int,
+) ([]T, error) {
+	// Capacity is limited so that a wrong count does not allocate too much
+	res := make([]T, 0, min(n, 1<<20))
+
+	for {
+		val, flags, err := conv(r)
+
+		if (flags & HasValue) == HasValue {
+			if len(res) == n {
+				return nil, fmt.Errorf("Unexpected data after %d elements", n)
+			}
+
+			res = append(res, val)
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(res) < n {
+		return nil, fmt.Errorf("Expected %d elements, found %d", n, len(res))
+	}
+
+	return res, nil
+}
+
+// Returns a copy of shape where lengths equal to ShapeFromInput
+// are replaced by count tokens read from the input in order
+func ReadShape(r *ByteReader, shape []int) ([]int, error) {
+	res := make([]int, len(shape))
+
+	for i, length := range shape {
+		if length == ShapeFromInput {
+			count, err := readCount(r)
+
+			if err == io.ErrUnexpectedEOF {
+				return nil, fmt.Errorf("Missing length of dimension %d", i)
+			} else if err != nil {
+				return nil, err
+			}
+
+			length = count
+		}
+
+		if length < 0 {
+			return nil, fmt.Errorf("Invalid length %d of dimension %d", length, i)
+		}
+
+		res[i] = length
+	}
+
+	return res, nil
+}
@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"context"
 	"errors"
 	"io"
 )
@@ -12,24 +13,49 @@ type SliceReader[T any] struct {
 	Buf1        []T
 	Buf2        [][]T
 	Buf3        [][][]T
+	blockCount  int
+	blockSizes  []int
 	byteReader  *ByteReader
 	conv        func(*ByteReader) (T, uint, error)
 	dim         uint
+	emptyLines  uint
+	opts        Options
 	prevNewline bool
+	rowCount    int
+	rowSizes    []int
+	spare2      [][]T
+	spare3      [][][]T
+}
+
+// Slices whose memory is reused by SliceReader.
+// Only the slice of the dimension of SliceReader is used.
+type Spare[T any] struct {
+	Buf1 []T
+	Buf2 [][]T
+	Buf3 [][][]T
 }
 
 // Constructs new SliceReader
 func NewSliceReader[T any](
 	byteReader *ByteReader, conv func(*ByteReader) (T, uint, error), dim uint,
+	opts Options,
 ) *SliceReader[T] {
 	res := &SliceReader[T]{
 		Buf1:        make([]T, 0),
 		Buf2:        nil,
 		Buf3:        nil,
+		blockCount:  0,
+		blockSizes:  nil,
 		byteReader:  byteReader,
 		conv:        conv,
 		dim:         dim,
+		emptyLines:  0,
+		opts:        opts,
 		prevNewline: false,
+		rowCount:    0,
+		rowSizes:    nil,
+		spare2:      nil,
+		spare3:      nil,
 	}
 
 	if dim >= 2 {
@@ -47,7 +73,7 @@ func NewSliceReader[T any](
 func (s *SliceReader[T]) add1Dto2D() {
 	if len(s.Buf1) > 0 {
 		s.Buf2 = append(s.Buf2, s.Buf1)
-		s.Buf1 = make([]T, 0)
+		s.Buf1 = s.newRow()
 	}
 }
 
@@ -55,7 +81,106 @@ func (s *SliceReader[T]) add1Dto2D() {
 func (s *SliceReader[T]) add2Dto3D() {
 	if len(s.Buf2) > 0 {
 		s.Buf3 = append(s.Buf3, s.Buf2)
-		s.Buf2 = make([][]T, 0)
+		s.Buf2 = s.newBlock()
+	}
+}
+
+// Adds a value to the current row.
+// If empty rows are preserved, empty lines before the value are added first.
+func (s *SliceReader[T]) addValue(val T) {
+	if s.emptyLines > 0 {
+		s.addEmptyLines()
+	}
+
+	s.Buf1 = append(s.Buf1, val)
+	s.prevNewline = false
+}
+
+// Adds empty rows or empty 2D slices for the empty lines
+// found since the last row
+func (s *SliceReader[T]) addEmptyLines() {
+	for ; s.emptyLines > 0; s.emptyLines-- {
+		if s.dim == 2 {
+			s.Buf2 = append(s.Buf2, make([]T, 0))
+		} else if s.dim == 3 {
+			s.Buf3 = append(s.Buf3, make([][]T, 0))
+		}
+	}
+}
+
+// Returns true if the number of elements of the outermost dimension
+// reached the limit from options
+func (s *SliceReader[T]) limitReached() bool {
+	if s.opts.Limit <= 0 {
+		return false
+	}
+
+	switch s.dim {
+	case 1:
+		return len(s.Buf1) >= s.opts.Limit
+	case 2:
+		return len(s.Buf2) >= s.opts.Limit
+	}
+
+	return len(s.Buf3) >= s.opts.Limit
+}
+
+// Returns an empty 2D slice for the next 2D slice.
+// Memory of the spare 2D slice at the same index is reused,
+// otherwise the capacity is the counted number of rows or RowsHint.
+func (s *SliceReader[T]) newBlock() [][]T {
+	k := s.blockCount
+	s.blockCount++
+
+	if i := len(s.Buf3); i < len(s.spare3) {
+		return s.spare3[i][:0]
+	}
+
+	if k < len(s.blockSizes) {
+		return make([][]T, 0, s.blockSizes[k])
+	}
+
+	return make([][]T, 0, max(s.opts.RowsHint, 0))
+}
+
+// Returns an empty 1D slice for the next row.
+// Memory of the spare row at the same index is reused,
+// otherwise the capacity is the counted number of values or ColsHint.
+func (s *SliceReader[T]) newRow() []T {
+	k := s.rowCount
+	s.rowCount++
+	spare := s.spare2
+
+	if s.dim == 3 {
+		spare = nil
+
+		if i := len(s.Buf3); i < len(s.spare3) {
+			spare = s.spare3[i]
+		}
+	}
+
+	if i := len(s.Buf2); i < len(spare) {
+		return spare[i][:0]
+	}
+
+	if k < len(s.rowSizes) {
+		return make([]T, 0, s.rowSizes[k])
+	}
+
+	return make([]T, 0, max(s.opts.ColsHint, 0))
+}
+
+// Processes newline symbol when empty rows are preserved.
+// The first empty line after a row ends the 2D slice,
+// other empty lines are counted and added once the next row is found.
+func (s *SliceReader[T]) processNewlinePreserve() {
+	if len(s.Buf1) > 0 {
+		s.Buf2 = append(s.Buf2, s.Buf1)
+		s.Buf1 = s.newRow()
+	} else if s.dim == 3 && len(s.Buf2) > 0 {
+		s.add2Dto3D()
+	} else {
+		s.emptyLines++
 	}
 }
 
@@ -63,6 +188,11 @@ func (s *SliceReader[T]) add2Dto3D() {
 // Two newlines in a row signalize that a 2D slice
 // is ready to be added to a 3D slice.
 func (s *SliceReader[T]) processNewline() {
+	if s.opts.PreserveEmpty && s.dim >= 2 {
+		s.processNewlinePreserve()
+		return
+	}
+
 	if s.dim == 2 {
 		s.add1Dto2D()
 		return
@@ -81,19 +211,77 @@ func (s *SliceReader[T]) processNewline() {
 	s.prevNewline = !s.prevNewline
 }
 
+// Allocates slices for the result before Run.
+// Memory of spare slices is reused and their elements are overwritten.
+// Other slices are allocated with capacities from rowSizes and blockSizes,
+// the numbers of values of rows and of rows of 2D slices,
+// or from hints in options.
+func (s *SliceReader[T]) Prepare(spare Spare[T], rowSizes, blockSizes []int) {
+	s.blockSizes, s.rowSizes = blockSizes, rowSizes
+	s.spare2, s.spare3 = spare.Buf2, spare.Buf3
+
+	switch s.dim {
+	case 1:
+		if spare.Buf1 != nil {
+			s.Buf1 = spare.Buf1[:0]
+		} else if rowSizes != nil {
+			total := 0
+
+			for _, size := range rowSizes {
+				total += size
+			}
+
+			s.Buf1 = make([]T, 0, total)
+		} else {
+			s.Buf1 = make([]T, 0, max(s.opts.ColsHint, 0))
+		}
+	case 2:
+		if spare.Buf2 != nil {
+			s.Buf2 = spare.Buf2[:0]
+		} else if rowSizes != nil {
+			s.Buf2 = make([][]T, 0, len(rowSizes))
+		} else {
+			s.Buf2 = make([][]T, 0, max(s.opts.RowsHint, 0))
+		}
+
+		s.Buf1 = s.newRow()
+	case 3:
+		if spare.Buf3 != nil {
+			s.Buf3 = spare.Buf3[:0]
+		} else if blockSizes != nil {
+			s.Buf3 = make([][][]T, 0, len(blockSizes))
+		}
+
+		s.Buf2 = s.newBlock()
+		s.Buf1 = s.newRow()
+	}
+}
+
 // Converts all bytes from ByteReader to the specified slice
-// of dimension s.dim
+// of dimension s.dim.
+// Stops early if the limit or the sentinel line from options is reached.
 func (s *SliceReader[T]) Run() error {
 	if s.conv == nil {
 		return errors.New("Conversion function is nil")
 	}
 
-	for {
+	if err := s.byteReader.SkipLines(s.opts.SkipLines); err != nil && err != io.EOF {
+		s.Buf1, s.Buf2, s.Buf3 = nil, nil, nil
+		return s.wrapError(err)
+	}
+
+	sentinel := []byte(s.opts.Sentinel)
+
+	for !s.limitReached() {
+		if len(sentinel) > 0 && s.byteReader.AtLineStart() &&
+			s.byteReader.SkipLine(sentinel) {
+			break
+		}
+
 		val, flags, err := s.conv(s.byteReader)
 
 		if (flags & HasValue) == HasValue {
-			s.Buf1 = append(s.Buf1, val)
-			s.prevNewline = false
+			s.addValue(val)
 		}
 
 		if err != nil {
@@ -102,7 +290,7 @@ func (s *SliceReader[T]) Run() error {
 			}
 
 			s.Buf1, s.Buf2, s.Buf3 = nil, nil, nil
-			return err
+			return s.wrapError(err)
 		}
 
 		if (flags & HasNewline) == HasNewline {
@@ -110,6 +298,12 @@ func (s *SliceReader[T]) Run() error {
 		}
 	}
 
+	s.finish()
+	return nil
+}
+
+// Adds the remaining row and 2D slice, empty lines at the end are ignored
+func (s *SliceReader[T]) finish() {
 	if s.dim >= 2 {
 		s.add1Dto2D()
 	}
@@ -118,16 +312,86 @@ func (s *SliceReader[T]) Run() error {
 		s.add2Dto3D()
 	}
 
-	return nil
+	if limit := s.opts.Limit; limit > 0 {
+		if s.dim == 1 && len(s.Buf1) > limit {
+			s.Buf1 = s.Buf1[:limit]
+		} else if s.dim == 2 && len(s.Buf2) > limit {
+			s.Buf2 = s.Buf2[:limit]
+		} else if s.dim == 3 && len(s.Buf3) > limit {
+			s.Buf3 = s.Buf3[:limit]
+		}
+	}
+}
+
+// Wraps err in PositionError if positions are enabled by options
+func (s *SliceReader[T]) wrapError(err error) error {
+	if s.opts.Positions {
+		return s.byteReader.WrapError(err)
+	}
+
+	return err
 }
 
-// Constructs and runs a SliceReader
+// Constructs and runs a SliceReader that reuses memory of spare slices
 func RunSliceReader[T any](
-	r io.Reader, chunkSize int,
-	conv func(*ByteReader) (T, uint, error), dim uint) (*SliceReader[T], error) {
+	r io.Reader, opts Options,
+	conv func(*ByteReader) (T, uint, error), dim uint, spare Spare[T],
+) (*SliceReader[T], error) {
+
+	if err := opts.Validate(); err != nil {
+		return &SliceReader[T]{}, err
+	}
+
+	if opts.Prefetch {
+		ctx := context.Background()
+
+		if c, ok := r.(*ContextReader); ok {
+			ctx = c.ctx
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		byteReader, done := newPrefetchByteReader(ctx, r, opts)
+
+		// r must not be read after returning
+		defer func() {
+			cancel()
+
+			if done != nil {
+				<-done
+			}
+		}()
+
+		return RunSliceReaderFrom(byteReader, opts, conv, dim, spare)
+	}
+
+	return RunSliceReaderFrom(NewByteReaderOptions(r, opts), opts, conv, dim, spare)
+}
+
+// Constructs and runs a SliceReader that reads from an existing ByteReader
+// and reuses memory of spare slices.
+// Bytes after the limit or the sentinel line stay unread in byteReader.
+func RunSliceReaderFrom[T any](
+	byteReader *ByteReader, opts Options,
+	conv func(*ByteReader) (T, uint, error), dim uint, spare Spare[T],
+) (*SliceReader[T], error) {
+
+	if err := opts.Validate(); err != nil {
+		return &SliceReader[T]{}, err
+	}
+
+	byteReader.SetSeparators(opts.RowSeparator, opts.BlockSeparator, opts.BlockMarker)
+	var rowSizes, blockSizes []int
+
+	if opts.Preallocate {
+		var err error
+
+		if rowSizes, blockSizes, err = CountSizes(byteReader, opts); err != nil {
+			return &SliceReader[T]{}, err
+		}
+	}
 
-	byteReader := NewByteReader(r, chunkSize)
-	sliceReader := NewSliceReader(byteReader, conv, dim)
+	sliceReader := NewSliceReader(byteReader, conv, dim, opts)
+	sliceReader.Prepare(spare, rowSizes, blockSizes)
 	err := sliceReader.Run()
 	return sliceReader, err
 }
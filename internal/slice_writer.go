@@ -0,0 +1,134 @@
+package internal
+
+import (
+	"errors"
+	"io"
+	"strconv"
+)
+
+// Writes 1D, 2D or 3D slice of T to the specified Writer.
+// For each element of type T, a format function format
+// appends its text form to the buffer.
+// Elements are separated by spaces, rows by newlines
+// and 2D slices by empty lines.
+type SliceWriter[T any] struct {
+	buf       []byte
+	chunkSize int
+	format    func([]byte, T) ([]byte, error)
+	impl      io.Writer
+}
+
+// Constructs new SliceWriter
+func NewSliceWriter[T any](
+	w io.Writer, chunkSize int, format func([]byte, T) ([]byte, error),
+) *SliceWriter[T] {
+	return &SliceWriter[T]{
+		buf:       make([]byte, 0, chunkSize),
+		chunkSize: chunkSize,
+		format:    format,
+		impl:      w,
+	}
+}
+
+// Writes the buffer to the underlying Writer
+func (s *SliceWriter[T]) Flush() error {
+	if len(s.buf) == 0 {
+		return nil
+	}
+
+	_, err := s.impl.Write(s.buf)
+	s.buf = s.buf[:0]
+	return err
+}
+
+// Writes the buffer if it is full
+func (s *SliceWriter[T]) flushIfFull() error {
+	if len(s.buf) >= s.chunkSize {
+		return s.Flush()
+	}
+
+	return nil
+}
+
+// Writes one row of elements terminated by a newline
+func (s *SliceWriter[T]) Write1D(data []T) error {
+	if s.format == nil {
+		return errors.New("Format function is nil")
+	}
+
+	var err error
+
+	for i, val := range data {
+		if i > 0 {
+			s.buf = append(s.buf, ' ')
+		}
+
+		if s.buf, err = s.format(s.buf, val); err != nil {
+			return err
+		}
+
+		if err = s.flushIfFull(); err != nil {
+			return err
+		}
+	}
+
+	s.buf = append(s.buf, '\n')
+	return s.flushIfFull()
+}
+
+// Writes rows of elements
+func (s *SliceWriter[T]) Write2D(data [][]T) error {
+	for _, row := range data {
+		if err := s.Write1D(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Writes 2D slices separated by empty lines
+func (s *SliceWriter[T]) Write3D(data [][][]T) error {
+	for i, block := range data {
+		if i > 0 {
+			s.buf = append(s.buf, '\n')
+		}
+
+		if err := s.Write2D(block); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Writes rows prefixed by their length, one row per line
+func (s *SliceWriter[T]) WriteRecords(data [][]T) error {
+	for _, row := range data {
+		s.buf = strconv.AppendInt(s.buf, int64(len(row)), 10)
+
+		if len(row) > 0 {
+			s.buf = append(s.buf, ' ')
+		}
+
+		if err := s.Write1D(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Constructs a SliceWriter, writes data with write and flushes the buffer
+func RunSliceWriter[T any](
+	w io.Writer, chunkSize int, format func([]byte, T) ([]byte, error),
+	write func(*SliceWriter[T]) error,
+) error {
+	writer := NewSliceWriter(w, chunkSize, format)
+
+	if err := write(writer); err != nil {
+		return err
+	}
+
+	return writer.Flush()
+}
@@ -0,0 +1,74 @@
+package internal
+
+import "io"
+
+// Adapter of io.ReadSeeker to io.ReaderAt
+type seekerAt struct {
+	impl io.ReadSeeker
+}
+
+// Reads len(p) bytes starting at offset off
+func (s seekerAt) ReadAt(p []byte, off int64) (int, error) {
+	if _, err := s.impl.Seek(off, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	return io.ReadFull(s.impl, p)
+}
+
+// Returns r as io.ReaderAt, seeking if r does not implement it
+func AsReaderAt(r io.ReadSeeker) io.ReaderAt {
+	if at, ok := r.(io.ReaderAt); ok {
+		return at
+	}
+
+	return seekerAt{impl: r}
+}
+
+// Scans r backwards from size and returns offset of the start
+// of the last n lines that are not empty.
+// Lines that contain only whitespace are empty, "\r\n" ends a line like "\n".
+func FindTailOffset(r io.ReaderAt, size int64, n int, chunkSize int) (int64, error) {
+	if n <= 0 {
+		return size, nil
+	}
+
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+
+	buf := make([]byte, chunkSize)
+	count := 0
+	hasContent := false
+
+	for end := size; end > 0; {
+		start := max(end-int64(chunkSize), 0)
+		chunk := buf[:end-start]
+
+		if _, err := r.ReadAt(chunk, start); err != nil && err != io.EOF {
+			return 0, err
+		}
+
+		for i := len(chunk) - 1; i >= 0; i-- {
+			switch b := chunk[i]; b {
+			case '\n':
+				if hasContent {
+					count++
+				}
+
+				if count == n {
+					return start + int64(i) + 1, nil
+				}
+
+				hasContent = false
+			case ' ', '\t', '\r':
+			default:
+				hasContent = true
+			}
+		}
+
+		end = start
+	}
+
+	return 0, nil
+}
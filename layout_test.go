@@ -0,0 +1,579 @@
+package gonumberio_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	r "reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	nio "github.com/Matej-Chmel/go-number-io"
+)
+
+func TestPreserveEmpty(t *testing.T) {
+	opts := nio.Options{PreserveEmpty: true}
+	conv := nio.GetConversion[int]()
+
+	data2, err := nio.Read2DOptions(strings.NewReader("\n1 2\n  \n\n3\n\n\n"), opts, conv)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected2 := [][]int{{}, {1, 2}, {}, {}, {3}}
+
+	if !r.DeepEqual(data2, expected2) {
+		t.Errorf("%v != %v", data2, expected2)
+	}
+
+	data3, err := nio.Read3DOptions(
+		strings.NewReader("1\n2\n\n\n\n3\r\n\r\n4 5\n\n"), opts, conv)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected3 := [][][]int{{{1}, {2}}, {}, {}, {{3}}, {{4, 5}}}
+
+	if !r.DeepEqual(data3, expected3) {
+		t.Errorf("%v != %v", data3, expected3)
+	}
+
+	dynamic, err := nio.ReadOptions[[][][]int](strings.NewReader("\n1\n"), opts)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := [][][]int{{}, {{1}}}; !r.DeepEqual(dynamic, expected) {
+		t.Errorf("%v != %v", dynamic, expected)
+	}
+
+	dropped, err := nio.Read3D[int](strings.NewReader("1\n2\n\n\n\n3"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := [][][]int{{{1}, {2}}, {{3}}}; !r.DeepEqual(dropped, expected) {
+		t.Errorf("%v != %v", dropped, expected)
+	}
+}
+
+func TestSeparators(t *testing.T) {
+	conv := nio.GetConversion[int]()
+
+	inline, err := nio.Read3DOptions(strings.NewReader("1 2;3 4|5 6;7 8\n"),
+		nio.Options{RowSeparator: ';', BlockSeparator: '|'}, conv)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := [][][]int{{{1, 2}, {3, 4}}, {{5, 6}, {7, 8}}}
+
+	if !r.DeepEqual(inline, expected) {
+		t.Errorf("%v != %v", inline, expected)
+	}
+
+	matlab, err := nio.Read3DOptions(strings.NewReader("1 2;\r\n3 4; \n\n5 6;\n"),
+		nio.Options{RowSeparator: ';'}, conv)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = [][][]int{{{1, 2}, {3, 4}}, {{5, 6}}}
+
+	if !r.DeepEqual(matlab, expected) {
+		t.Errorf("%v != %v", matlab, expected)
+	}
+
+	for _, chunkSize := range []int{1, 2, 3, nio.DefaultChunkSize} {
+		marker, err := nio.Read3DOptions(
+			strings.NewReader("---\n1 -2\n3 4\n---\r\n-5 6\n---\n--- \n-7\n---"),
+			nio.Options{ChunkSize: chunkSize, BlockMarker: "---"}, conv)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected = [][][]int{{{1, -2}, {3, 4}}, {{-5, 6}}, {{-7}}}
+
+		if !r.DeepEqual(marker, expected) {
+			t.Errorf("Chunk size %d: %v != %v", chunkSize, marker, expected)
+		}
+	}
+
+	for _, input := range []string{"1 2\n3 4\n|\n5 6", "1 2\n3 4|5 6"} {
+		ownLine, err := nio.Read3DOptions(strings.NewReader(input),
+			nio.Options{BlockSeparator: '|', PreserveEmpty: true}, conv)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected = [][][]int{{{1, 2}, {3, 4}}, {{5, 6}}}
+
+		if !r.DeepEqual(ownLine, expected) {
+			t.Errorf("%q: %v != %v", input, ownLine, expected)
+		}
+	}
+
+	if _, err = nio.Read2DOptions(strings.NewReader("1 2"),
+		nio.Options{RowSeparator: '.'}, conv); err == nil {
+		t.Error("Expected error for a dot separator")
+	}
+}
+
+func TestShortReads(t *testing.T) {
+	file, err := openFile[int](3)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer file.Close()
+	reader := iotest.DataErrReader(iotest.OneByteReader(file))
+	data, err := nio.Read3DCustom(reader, 2, nio.GetConversion[int]())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !r.DeepEqual(data, intD3) {
+		t.Errorf("%v != %v", data, intD3)
+	}
+}
+
+func TestPrefetch(t *testing.T) {
+	input := "1 2 3\n4 5\n\n6\n7 8 9 10\n"
+	expected := [][]int{{1, 2, 3}, {4, 5}, {6}, {7, 8, 9, 10}}
+	conv := nio.GetConversion[int]()
+	readers := []func() io.Reader{
+		func() io.Reader { return iotest.OneByteReader(strings.NewReader(input)) },
+		func() io.Reader { return iotest.HalfReader(strings.NewReader(input)) },
+		func() io.Reader { return iotest.DataErrReader(strings.NewReader(input)) },
+	}
+
+	for _, chunkSize := range []int{1, 4, nio.DefaultChunkSize} {
+		for _, newReader := range readers {
+			opts := nio.Options{ChunkSize: chunkSize, Prefetch: true}
+			actual, err := nio.Read2DOptions(newReader(), opts, conv)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !r.DeepEqual(actual, expected) {
+				t.Errorf("Chunk size %d: %v != %v", chunkSize, actual, expected)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			actual, err = nio.Read2DFrom(
+				nio.NewPrefetchByteReader(ctx, newReader(), chunkSize), nio.Options{}, conv)
+			cancel()
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !r.DeepEqual(actual, expected) {
+				t.Errorf("Chunk size %d: %v != %v", chunkSize, actual, expected)
+			}
+		}
+	}
+
+	for _, opts := range []nio.Options{
+		{Limit: 3, Prefetch: true}, {Prefetch: true, Sentinel: "END"},
+	} {
+		if _, err := nio.Read2DOptions(strings.NewReader(input), opts, conv); err == nil {
+			t.Errorf("Expected error for %+v", opts)
+		}
+	}
+
+	bad := "1 2\n3 4\n5 6\n7 8\n9 x\n"
+
+	for _, prefetch := range []bool{false, true} {
+		opts := nio.Options{ChunkSize: 4, Positions: true, Prefetch: prefetch}
+		_, err := nio.Read2DOptions(strings.NewReader(bad), opts, conv)
+		var posErr *nio.PositionError
+
+		if !errors.As(err, &posErr) || posErr.Line != 5 {
+			t.Errorf("Prefetch %t: expected error at line 5, got %v", prefetch, err)
+		}
+	}
+
+	broken := errors.New("broken")
+	_, err := nio.Read2DOptions(
+		io.MultiReader(strings.NewReader(input), iotest.ErrReader(broken)),
+		nio.Options{ChunkSize: 4, Prefetch: true}, conv)
+
+	if !errors.Is(err, broken) {
+		t.Errorf("Expected error of the reader, got %v", err)
+	}
+
+	pipeReader, pipeWriter := io.Pipe()
+	defer pipeWriter.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	go pipeWriter.Write([]byte("1 2\n3"))
+
+	_, err = nio.Read2DFrom(nio.NewPrefetchByteReader(ctx, pipeReader, 0), nio.Options{}, conv)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestShape(t *testing.T) {
+	wrapped, err := nio.Read2DShape[int](
+		strings.NewReader("2 5\n1 2 3\n4 5\n6 7 8 9\n10\n"),
+		nio.Shape{nio.ShapeFromInput, nio.ShapeFromInput})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := [][]int{{1, 2, 3, 4, 5}, {6, 7, 8, 9, 10}}
+
+	if !r.DeepEqual(wrapped, expected) {
+		t.Errorf("%v != %v", wrapped, expected)
+	}
+
+	blocks, err := nio.Read3DShape[int](
+		strings.NewReader("2\n1 2 3 4 5 6 7 8"), nio.Shape{nio.ShapeFromInput, 2, 2})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := [][][]int{{{1, 2}, {3, 4}}, {{5, 6}, {7, 8}}}; !r.DeepEqual(blocks, expected) {
+		t.Errorf("%v != %v", blocks, expected)
+	}
+
+	for _, input := range []string{"1 2 3", "1 2 3 4 5"} {
+		if _, err = nio.Read2DShape[int](strings.NewReader(input), nio.Shape{2, 2}); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+
+	if _, err = nio.Read1DShape[int](strings.NewReader(""), nio.Shape{nio.ShapeFromInput}); err == nil {
+		t.Error("Expected error for a missing count")
+	}
+
+	for _, input := range []string{"18446744073709551615 1", "9223372036854775808"} {
+		if _, err = nio.Read1DShape[int](
+			strings.NewReader(input), nio.Shape{nio.ShapeFromInput}); err == nil {
+			t.Errorf("Expected error for count in %q", input)
+		}
+	}
+
+	if _, err = nio.Read3DShape[int](strings.NewReader(""),
+		nio.Shape{math.MaxInt / 2, 4, 0}); err == nil {
+		t.Error("Expected error for too many 2D slices")
+	}
+
+	if _, err = nio.Read2DShape[int](strings.NewReader("20000000000000 0"),
+		nio.Shape{nio.ShapeFromInput, nio.ShapeFromInput}); err == nil {
+		t.Error("Expected error for too many empty rows")
+	}
+
+	if _, err = nio.Read3DShape[int](strings.NewReader(""),
+		nio.Shape{20000000000000, 0, 0}); err == nil {
+		t.Error("Expected error for too many empty 2D slices")
+	}
+
+	empty, err := nio.Read3DShape[int](strings.NewReader("2 3 0"),
+		nio.Shape{nio.ShapeFromInput, nio.ShapeFromInput, nio.ShapeFromInput})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := [][][]int{{{}, {}, {}}, {{}, {}, {}}}; !r.DeepEqual(empty, expected) {
+		t.Errorf("%v != %v", empty, expected)
+	}
+}
+
+func TestRecords(t *testing.T) {
+	records, err := nio.ReadRecords[int](strings.NewReader("3 7 9 12\n0\n\n1 -5 \r\n"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := [][]int{{7, 9, 12}, {}, {-5}}
+
+	if !r.DeepEqual(records, expected) {
+		t.Errorf("%v != %v", records, expected)
+	}
+
+	var sb strings.Builder
+
+	if err = nio.WriteRecords(&sb, records); err != nil {
+		t.Fatal(err)
+	} else if sb.String() != "3 7 9 12\n0\n1 -5\n" {
+		t.Errorf("Unexpected output %q", sb.String())
+	}
+
+	for _, input := range []string{"3 7 9\n12", "2 1 2 1 3", "2 1"} {
+		if _, err = nio.ReadRecords[int](strings.NewReader(input)); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+
+	spanning, err := nio.ReadRecordsOptions(strings.NewReader("3 7 9\n12 2 1\n\n2"),
+		nio.Options{}, true, nio.GetConversion[int]())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := [][]int{{7, 9, 12}, {1, 2}}; !r.DeepEqual(spanning, expected) {
+		t.Errorf("%v != %v", spanning, expected)
+	}
+}
+
+func TestRange(t *testing.T) {
+	conv := nio.GetConversion[int]()
+	input := "header line\n# another\n1 2\n3 4\n5 6\nEND\n7 8\n9\n"
+
+	rows, err := nio.Read2DOptions(strings.NewReader(input),
+		nio.Options{SkipLines: 3, Limit: 2}, conv)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := [][]int{{3, 4}, {5, 6}}; !r.DeepEqual(rows, expected) {
+		t.Errorf("%v != %v", rows, expected)
+	}
+
+	reader := nio.NewByteReader(strings.NewReader(input), 4)
+	opts := nio.Options{SkipLines: 2, Sentinel: "END"}
+	first, err := nio.Read2DFrom(reader, opts, conv)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := [][]int{{1, 2}, {3, 4}, {5, 6}}; !r.DeepEqual(first, expected) {
+		t.Errorf("%v != %v", first, expected)
+	}
+
+	next, err := nio.Read1DFrom(reader, nio.Options{Limit: 1}, conv)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := []int{7}; !r.DeepEqual(next, expected) {
+		t.Errorf("%v != %v", next, expected)
+	}
+
+	rest, err := nio.Read2DFrom(reader, nio.Options{}, conv)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := [][]int{{8}, {9}}; !r.DeepEqual(rest, expected) {
+		t.Errorf("%v != %v", rest, expected)
+	}
+
+	blocks, err := nio.Read3DOptions(strings.NewReader("1\n\n\n2\n\n3\n"),
+		nio.Options{Limit: 2, PreserveEmpty: true}, conv)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := [][][]int{{{1}}, {}}; !r.DeepEqual(blocks, expected) {
+		t.Errorf("%v != %v", blocks, expected)
+	}
+}
+
+func TestReuse(t *testing.T) {
+	inputs := []string{
+		"1 2 3\n4 5\n\n6\n", "7 8 9 10\n\n\n11\n12 13\n14\n", "15\n", "", "16 17\n\n18 19 20\n",
+	}
+	opts := []nio.Options{{}, {PreserveEmpty: true}}
+	conv := nio.GetConversion[int]()
+
+	for _, opt := range opts {
+		var dst1 []int
+		var dst2 [][]int
+		var dst3 [][][]int
+
+		for _, input := range inputs {
+			expected1, _ := nio.Read1DOptions(strings.NewReader(input), opt, conv)
+			expected2, _ := nio.Read2DOptions(strings.NewReader(input), opt, conv)
+			expected3, _ := nio.Read3DOptions(strings.NewReader(input), opt, conv)
+			var err error
+
+			if dst1, err = nio.Read1DIntoOptions(strings.NewReader(input), dst1, opt, conv); err != nil {
+				t.Fatal(err)
+			}
+
+			if dst2, err = nio.Read2DIntoOptions(strings.NewReader(input), dst2, opt, conv); err != nil {
+				t.Fatal(err)
+			}
+
+			if dst3, err = nio.Read3DIntoOptions(strings.NewReader(input), dst3, opt, conv); err != nil {
+				t.Fatal(err)
+			}
+
+			if !r.DeepEqual(dst1, expected1) || !r.DeepEqual(dst2, expected2) ||
+				!r.DeepEqual(dst3, expected3) {
+				t.Errorf("%q: %v, %v, %v != %v, %v, %v",
+					input, dst1, dst2, dst3, expected1, expected2, expected3)
+			}
+		}
+	}
+
+	input := strings.NewReader("1 2 3\n4 5 6\n")
+	decoder := nio.NewDecoder[int](input)
+	first, err := decoder.Read2D(nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input.Reset("7 8 9\n10 11 12\n")
+	decoder.Reset(input)
+	second, err := decoder.Read2D(first)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := [][]int{{7, 8, 9}, {10, 11, 12}}; !r.DeepEqual(second, expected) {
+		t.Errorf("%v != %v", second, expected)
+	}
+
+	if &second[0] != &first[0] || &second[1][0] != &first[1][0] {
+		t.Error("Memory of the previous result was not reused")
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		input.Reset("1 2 3\n4 5 6\n")
+		decoder.Reset(input)
+
+		if second, err = decoder.Read2D(second); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	if allocs > 2 {
+		t.Errorf("%v allocations when reading into reused memory", allocs)
+	}
+}
+
+func TestPreallocate(t *testing.T) {
+	input := "header\n1 2 3\n4 5\r\n\r\n6;7 8 9 10|11\n--\n12 13\nEND\n14\n"
+	conv := nio.GetConversion[int]()
+	opts := []nio.Options{{}, {PreserveEmpty: true}, {ChunkSize: 3, Limit: 2}, {ColsHint: 3, RowsHint: 2}}
+
+	for _, opt := range opts {
+		opt.BlockMarker, opt.BlockSeparator, opt.RowSeparator = "--", '|', ';'
+		opt.Sentinel, opt.SkipLines = "END", 1
+		expected2, err := nio.Read2DOptions(strings.NewReader(input), opt, conv)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected3, err := nio.Read3DOptions(strings.NewReader(input), opt, conv)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		opt.Preallocate = true
+		readers := []io.Reader{
+			strings.NewReader(input), iotest.OneByteReader(strings.NewReader(input)),
+		}
+
+		for _, reader := range readers {
+			actual, err := nio.Read2DOptions(reader, opt, conv)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !r.DeepEqual(actual, expected2) {
+				t.Errorf("%v != %v", actual, expected2)
+			}
+		}
+
+		actual2, err := nio.ReadBytesOptions[[][]int]([]byte(input), opt)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual3, err := nio.Read3DOptions(strings.NewReader(input), opt, conv)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !r.DeepEqual(actual2, expected2) || !r.DeepEqual(actual3, expected3) {
+			t.Errorf("%v, %v != %v, %v", actual2, actual3, expected2, expected3)
+		}
+	}
+
+	data, err := nio.ReadStringOptions[[][]int](
+		"1 2 3\n4\n5 6\n", nio.Options{Preallocate: true})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cap(data) != len(data) || cap(data[0]) != 3 || cap(data[1]) != 1 || cap(data[2]) != 2 {
+		t.Errorf("Slices of %v were not allocated with exact capacities", data)
+	}
+
+	for _, chunkSize := range []int{1, 2, 3, 7, nio.DefaultChunkSize} {
+		opt := nio.Options{ChunkSize: chunkSize, Preallocate: true, RowSeparator: ';'}
+		data, err = nio.Read2DOptions(
+			strings.NewReader("12 345\r\n6\r7 8; 9\n\n  10 11 12  \n13"), opt, conv)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, row := range data {
+			if cap(row) != len(row) {
+				t.Errorf("Chunk size %d: capacity of %v is %d", chunkSize, row, cap(row))
+			}
+		}
+	}
+
+	// Counting must not move a ByteReader that already read a part of the input
+	reader := strings.NewReader("1 2\n3 4 5\n6\n")
+	byteReader := nio.NewByteReader(reader, 2)
+	opt := nio.Options{Limit: 1, Preallocate: true}
+	first, err := nio.Read2DFrom(byteReader, opt, conv)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rest, err := nio.Read2DFrom(byteReader, nio.Options{Preallocate: true}, conv)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := [][]int{{3, 4, 5}, {6}}; !r.DeepEqual(first, [][]int{{1, 2}}) ||
+		!r.DeepEqual(rest, expected) {
+		t.Errorf("%v, %v != [[1 2]], %v", first, rest, expected)
+	}
+}
@@ -0,0 +1,47 @@
+package gonumberio
+
+import (
+	"unsafe"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+// Constructs new ByteReader that parses data directly without copying.
+// []byte elements read by ConvertBytes are views of data.
+func NewByteReaderBytes(data []byte) *ByteReader {
+	return ite.NewByteReader(ite.NewMemoryReader(data, false), 0)
+}
+
+// Constructs new ByteReader that parses s directly without copying
+func NewByteReaderString(s string) *ByteReader {
+	return ite.NewByteReader(ite.NewMemoryReader(stringBytes(s), true), 0)
+}
+
+// Reads T from data without copying.
+// T can be a single element or 1D, 2D or 3D slice.
+func ReadBytes[T any](data []byte) (T, error) {
+	return ReadBytesOptions[T](data, Options{})
+}
+
+// Reads T from data without copying with options.
+// T can be a single element or 1D, 2D or 3D slice.
+func ReadBytesOptions[T any](data []byte, opts Options) (T, error) {
+	return ReadOptions[T](ite.NewMemoryReader(data, false), opts)
+}
+
+// Reads T from s without copying.
+// T can be a single element or 1D, 2D or 3D slice.
+func ReadString[T any](s string) (T, error) {
+	return ReadStringOptions[T](s, Options{})
+}
+
+// Reads T from s without copying with options.
+// T can be a single element or 1D, 2D or 3D slice.
+func ReadStringOptions[T any](s string, opts Options) (T, error) {
+	return ReadOptions[T](ite.NewMemoryReader(stringBytes(s), true), opts)
+}
+
+// Returns bytes of s without copying, the bytes must not be modified
+func stringBytes(s string) []byte {
+	return unsafe.Slice(unsafe.StringData(s), len(s))
+}
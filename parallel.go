@@ -0,0 +1,73 @@
+package gonumberio
+
+import (
+	"context"
+	"io"
+	"runtime"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+// Read 2D slice of T from the first size bytes of r in parallel
+// with default conversion
+func Read2DParallel[T any](r io.ReaderAt, size int64) ([][]T, error) {
+	return Read2DParallelOptions(r, size, 0, Options{}, GetConversion[T]())
+}
+
+// Read 2D slice of T from the first size bytes of r in parallel with options.
+// The input is split at newlines into one section for each of workers goroutines,
+// if workers is not positive, runtime.GOMAXPROCS(0) is used.
+// Rows must not span lines. Results and errors are the same as of Read2DOptions.
+// With PreserveEmpty, Limit or Sentinel, the input is read sequentially.
+func Read2DParallelOptions[T any](
+	r io.ReaderAt, size int64, workers int, opts Options,
+	conv func(*ByteReader) (T, uint, error)) ([][]T, error) {
+
+	return Read2DParallelContext(context.Background(), r, size, workers, opts, conv)
+}
+
+// Read 2D slice of T from the first size bytes of r in parallel with options
+// like Read2DParallelOptions until ctx is done
+func Read2DParallelContext[T any](
+	ctx context.Context, r io.ReaderAt, size int64, workers int, opts Options,
+	conv func(*ByteReader) (T, uint, error)) ([][]T, error) {
+
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if workers == 1 || opts.PreserveEmpty || opts.Limit > 0 || opts.Sentinel != "" {
+		return Read2DContext(ctx, io.NewSectionReader(r, 0, size), opts, conv)
+	}
+
+	var start int64 = 0
+	firstLine := 1
+
+	if opts.SkipLines > 0 {
+		byteReader := ite.NewByteReader(
+			WithContext(ctx, io.NewSectionReader(r, 0, size)), opts.ChunkSize)
+
+		if err := byteReader.SkipLines(opts.SkipLines); err != nil && err != io.EOF {
+			if opts.Positions {
+				err = byteReader.WrapError(err)
+			}
+
+			return nil, err
+		}
+
+		state := byteReader.State()
+		start, firstLine = state.Offset, state.Line
+	}
+
+	bounds, err := ite.SplitLines(r, start, size, workers)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ite.RunParallel2D(ctx, r, bounds, firstLine, opts, conv)
+}
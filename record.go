@@ -0,0 +1,105 @@
+package gonumberio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+// Read rows prefixed by their length, like "3 7 9 12" for row {7, 9, 12}.
+// Each row must be on one line.
+func ReadRecords[T any](r io.Reader) ([][]T, error) {
+	return ReadRecordsOptions(r, Options{}, false, GetConversion[T]())
+}
+
+// Read rows prefixed by their length with options.
+// If spanLines is true, rows may span several lines and
+// several rows may be on one line.
+func ReadRecordsOptions[T any](
+	r io.Reader, opts Options, spanLines bool,
+	conv func(*ByteReader) (T, uint, error)) ([][]T, error) {
+
+	if conv == nil {
+		return nil, errors.New("Conversion function is nil")
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	byteReader := ite.NewByteReaderOptions(r, opts)
+	res := make([][]T, 0)
+	lineOpen := false
+
+	for {
+		length, flags, err := ConvertUnsigned[uint](byteReader)
+
+		if (flags & HasValueFlag) == 0 {
+			if err == io.EOF {
+				return res, nil
+			} else if err != nil {
+				return nil, err
+			}
+
+			lineOpen = false
+			continue
+		}
+
+		if lineOpen && !spanLines {
+			return nil, fmt.Errorf("Record %d starts on the line of record %d",
+				len(res), len(res)-1)
+		}
+
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		atEnd := err == io.EOF
+		lineOpen = (flags & HasNewlineFlag) == 0
+		row := make([]T, 0, min(length, 1<<16))
+
+		for uint(len(row)) < length {
+			if atEnd || (!lineOpen && !spanLines) {
+				return nil, fmt.Errorf("Record %d has %d values, expected %d",
+					len(res), len(row), length)
+			}
+
+			val, flags, err := conv(byteReader)
+
+			if (flags & HasValueFlag) == HasValueFlag {
+				row = append(row, val)
+			}
+
+			if err == io.EOF {
+				atEnd = true
+			} else if err != nil {
+				return nil, err
+			}
+
+			lineOpen = (flags & HasNewlineFlag) == 0
+		}
+
+		res = append(res, row)
+
+		if atEnd {
+			return res, nil
+		}
+	}
+}
+
+// Write rows of type T to a Writer, each row on one line prefixed by its length
+func WriteRecords[T any](w io.Writer, data [][]T) error {
+	return WriteRecordsCustom(w, DefaultChunkSize, data, GetFormat[T]())
+}
+
+// Write rows of type T to a Writer, each row on one line
+// prefixed by its length with options
+func WriteRecordsCustom[T any](
+	w io.Writer, chunkSize int, data [][]T,
+	format func([]byte, T) ([]byte, error)) error {
+
+	return ite.RunSliceWriter(w, chunkSize, format,
+		func(s *ite.SliceWriter[T]) error { return s.WriteRecords(data) })
+}
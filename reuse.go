@@ -0,0 +1,112 @@
+package gonumberio
+
+import (
+	"io"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+// Reads 1D, 2D or 3D slices of T from several inputs one after another.
+// The buffer of ByteReader is reused for each input.
+type Decoder[T any] struct {
+	byteReader *ByteReader
+	conv       func(*ByteReader) (T, uint, error)
+	opts       Options
+}
+
+// Constructs new Decoder with default conversion
+func NewDecoder[T any](r io.Reader) *Decoder[T] {
+	return &Decoder[T]{
+		byteReader: ite.NewByteReader(r, DefaultChunkSize),
+		conv:       GetConversion[T](),
+		opts:       Options{},
+	}
+}
+
+// Constructs new Decoder with options, Prefetch is not used
+func NewDecoderOptions[T any](
+	r io.Reader, opts Options,
+	conv func(*ByteReader) (T, uint, error)) (*Decoder[T], error) {
+
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Decoder[T]{
+		byteReader: ite.NewByteReaderOptions(r, opts),
+		conv:       conv,
+		opts:       opts,
+	}, nil
+}
+
+// Reads a 1D slice into the memory of dst
+func (d *Decoder[T]) Read1D(dst []T) ([]T, error) {
+	reader, err := ite.RunSliceReaderFrom(
+		d.byteReader, d.opts, d.conv, 1, ite.Spare[T]{Buf1: dst})
+	return reader.Buf1, err
+}
+
+// Reads a 2D slice into the memory of dst
+func (d *Decoder[T]) Read2D(dst [][]T) ([][]T, error) {
+	reader, err := ite.RunSliceReaderFrom(
+		d.byteReader, d.opts, d.conv, 2, ite.Spare[T]{Buf2: dst})
+	return reader.Buf2, err
+}
+
+// Reads a 3D slice into the memory of dst
+func (d *Decoder[T]) Read3D(dst [][][]T) ([][][]T, error) {
+	reader, err := ite.RunSliceReaderFrom(
+		d.byteReader, d.opts, d.conv, 3, ite.Spare[T]{Buf3: dst})
+	return reader.Buf3, err
+}
+
+// Makes the Decoder read from r, the buffer is kept
+func (d *Decoder[T]) Reset(r io.Reader) {
+	d.byteReader.Reset(r)
+}
+
+// Read a 1D slice of type T from a Reader into the memory of dst.
+// dst grows only if it is too small, its elements are overwritten.
+func Read1DInto[T any](r io.Reader, dst []T) ([]T, error) {
+	return Read1DIntoOptions(r, dst, Options{}, GetConversion[T]())
+}
+
+// Read a 1D slice of type T from a Reader into the memory of dst with options
+func Read1DIntoOptions[T any](
+	r io.Reader, dst []T, opts Options,
+	conv func(*ByteReader) (T, uint, error)) ([]T, error) {
+
+	reader, err := ite.RunSliceReader(r, opts, conv, 1, ite.Spare[T]{Buf1: dst})
+	return reader.Buf1, err
+}
+
+// Read a 2D slice of type T from a Reader into the memory of dst.
+// dst and its rows grow only if they are too small, elements are overwritten.
+func Read2DInto[T any](r io.Reader, dst [][]T) ([][]T, error) {
+	return Read2DIntoOptions(r, dst, Options{}, GetConversion[T]())
+}
+
+// Read a 2D slice of type T from a Reader into the memory of dst with options
+func Read2DIntoOptions[T any](
+	r io.Reader, dst [][]T, opts Options,
+	conv func(*ByteReader) (T, uint, error)) ([][]T, error) {
+
+	reader, err := ite.RunSliceReader(r, opts, conv, 2, ite.Spare[T]{Buf2: dst})
+	return reader.Buf2, err
+}
+
+// Read a 3D slice of type T from a Reader into the memory of dst.
+// dst, its 2D slices and rows grow only if they are too small,
+// elements are overwritten.
+func Read3DInto[T any](r io.Reader, dst [][][]T) ([][][]T, error) {
+	return Read3DIntoOptions(r, dst, Options{}, GetConversion[T]())
+}
+
+// Read a 3D slice of type T from a Reader into the memory of dst with options
+func Read3DIntoOptions[T any](
+	r io.Reader, dst [][][]T, opts Options,
+	conv func(*ByteReader) (T, uint, error)) ([][][]T, error) {
+
+	reader, err := ite.RunSliceReader(r, opts, conv, 3, ite.Spare[T]{Buf3: dst})
+	return reader.Buf3, err
+}
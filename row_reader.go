@@ -0,0 +1,167 @@
+package gonumberio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+// Reads rows of type T one at a time.
+// Empty lines are skipped and separate 2D slices.
+type RowReader[T any] struct {
+	block      int
+	byteReader *ByteReader
+	conv       func(*ByteReader) (T, uint, error)
+	err        error
+	newBlock   bool
+	opts       Options
+	rows       int
+	sentinel   []byte
+}
+
+// Constructs new RowReader with default conversion
+func NewRowReader[T any](r io.Reader) *RowReader[T] {
+	return newRowReader(
+		ite.NewByteReader(r, DefaultChunkSize), Options{}, GetConversion[T]())
+}
+
+// Constructs new RowReader with options
+func NewRowReaderOptions[T any](
+	r io.Reader, opts Options,
+	conv func(*ByteReader) (T, uint, error)) (*RowReader[T], error) {
+
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	res := newRowReader(ite.NewByteReaderOptions(r, opts), opts, conv)
+
+	if err := res.byteReader.SkipLines(opts.SkipLines); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Constructs new RowReader that follows a growing input like "tail -f".
+// At the end of input, the reader waits for more data and checks
+// every interval until ctx is done. Next returns only complete rows
+// terminated by a newline and the error of ctx when it is done.
+func NewFollowRowReader[T any](
+	ctx context.Context, r io.Reader, interval time.Duration, opts Options,
+	conv func(*ByteReader) (T, uint, error)) (*RowReader[T], error) {
+
+	if interval <= 0 {
+		return nil, errors.New("Poll interval must be positive")
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	byteReader := ite.NewByteReaderOptions(r, opts)
+	byteReader.Follow(ctx, interval)
+	res := newRowReader(byteReader, opts, conv)
+
+	if err := byteReader.SkipLines(opts.SkipLines); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Internal constructor of RowReader.
+// Lines are counted for the line number of Checkpoint.
+func newRowReader[T any](
+	byteReader *ByteReader, opts Options,
+	conv func(*ByteReader) (T, uint, error)) *RowReader[T] {
+
+	byteReader.CountLines()
+
+	return &RowReader[T]{
+		block:      0,
+		byteReader: byteReader,
+		conv:       conv,
+		err:        nil,
+		newBlock:   false,
+		opts:       opts,
+		rows:       0,
+		sentinel:   []byte(opts.Sentinel),
+	}
+}
+
+// Returns index of the 2D slice of the last row returned by Next
+func (rr *RowReader[T]) Block() int {
+	return rr.block
+}
+
+// Returns the next row that is not empty.
+// Returns io.EOF after the last row, the limit or the sentinel line.
+func (rr *RowReader[T]) Next() ([]T, error) {
+	if rr.err != nil {
+		return nil, rr.err
+	}
+
+	if rr.conv == nil {
+		rr.err = errors.New("Conversion function is nil")
+		return nil, rr.err
+	}
+
+	if rr.opts.Limit > 0 && rr.rows >= rr.opts.Limit {
+		rr.err = io.EOF
+		return nil, rr.err
+	}
+
+	row := make([]T, 0)
+
+	for {
+		if len(row) == 0 && len(rr.sentinel) > 0 &&
+			rr.byteReader.AtLineStart() && rr.byteReader.SkipLine(rr.sentinel) {
+			rr.err = io.EOF
+			return nil, rr.err
+		}
+
+		val, flags, err := rr.conv(rr.byteReader)
+
+		if (flags & HasValueFlag) == HasValueFlag {
+			row = append(row, val)
+		}
+
+		if err != nil {
+			rr.err = err
+
+			if err == io.EOF && len(row) > 0 {
+				return rr.addRow(row), nil
+			}
+
+			return nil, err
+		}
+
+		if (flags & HasNewlineFlag) == HasNewlineFlag {
+			if len(row) > 0 {
+				return rr.addRow(row), nil
+			}
+
+			rr.newBlock = true
+		}
+	}
+}
+
+// Returns number of rows returned by Next
+func (rr *RowReader[T]) Rows() int {
+	return rr.rows
+}
+
+// Updates counters for a row returned by Next
+func (rr *RowReader[T]) addRow(row []T) []T {
+	if rr.newBlock && rr.rows > 0 {
+		rr.block++
+	}
+
+	rr.newBlock = false
+	rr.rows++
+	return row
+}
@@ -0,0 +1,150 @@
+package gonumberio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+const (
+	// Length of a dimension that is read from a count token in the input
+	ShapeFromInput int = ite.ShapeFromInput
+)
+
+// Lengths of dimensions of a slice, outermost first.
+// Lengths equal to ShapeFromInput are read from leading count tokens
+// in the input, for example Shape{ShapeFromInput, ShapeFromInput}
+// reads "rows cols" before the elements.
+// A shape without elements may have at most 1<<20 rows and 2D slices.
+type Shape []int
+
+// Read a 1D slice of type T with the specified shape from a Reader
+func Read1DShape[T any](r io.Reader, shape Shape) ([]T, error) {
+	return Read1DShapeOptions(r, shape, Options{}, GetConversion[T]())
+}
+
+// Read a 1D slice of type T with the specified shape from a Reader with options
+func Read1DShapeOptions[T any](
+	r io.Reader, shape Shape, opts Options,
+	conv func(*ByteReader) (T, uint, error)) ([]T, error) {
+
+	flat, _, err := readShaped(r, shape, 1, opts, conv)
+	return flat, err
+}
+
+// Read a 2D slice of type T with the specified shape from a Reader
+func Read2DShape[T any](r io.Reader, shape Shape) ([][]T, error) {
+	return Read2DShapeOptions(r, shape, Options{}, GetConversion[T]())
+}
+
+// Read a 2D slice of type T with the specified shape from a Reader with options.
+// Rows are filled by count, so one row may span several lines.
+func Read2DShapeOptions[T any](
+	r io.Reader, shape Shape, opts Options,
+	conv func(*ByteReader) (T, uint, error)) ([][]T, error) {
+
+	flat, lengths, err := readShaped(r, shape, 2, opts, conv)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return split2D(flat, lengths[0], lengths[1]), nil
+}
+
+// Read a 3D slice of type T with the specified shape from a Reader
+func Read3DShape[T any](r io.Reader, shape Shape) ([][][]T, error) {
+	return Read3DShapeOptions(r, shape, Options{}, GetConversion[T]())
+}
+
+// Read a 3D slice of type T with the specified shape from a Reader with options.
+// Rows are filled by count, so one row may span several lines.
+func Read3DShapeOptions[T any](
+	r io.Reader, shape Shape, opts Options,
+	conv func(*ByteReader) (T, uint, error)) ([][][]T, error) {
+
+	flat, lengths, err := readShaped(r, shape, 3, opts, conv)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows := split2D(flat, lengths[0]*lengths[1], lengths[2])
+	res := make([][][]T, lengths[0])
+
+	for i := range res {
+		res[i] = rows[i*lengths[1] : (i+1)*lengths[1] : (i+1)*lengths[1]]
+	}
+
+	return res, nil
+}
+
+// Internal implementation of reading functions with a shape.
+// Returns the elements in one slice and lengths of all dimensions.
+func readShaped[T any](
+	r io.Reader, shape Shape, dims int, opts Options,
+	conv func(*ByteReader) (T, uint, error)) ([]T, []int, error) {
+
+	if len(shape) != dims {
+		return nil, nil, fmt.Errorf(
+			"Shape has %d dimensions, expected %d", len(shape), dims)
+	}
+
+	if conv == nil {
+		return nil, nil, errors.New("Conversion function is nil")
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	byteReader := ite.NewByteReaderOptions(r, opts)
+	lengths, err := ite.ReadShape(byteReader, shape)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Products of outer lengths must not overflow even if an inner length is 0
+	n, nonZero := 1, 1
+
+	for _, length := range lengths {
+		if length > 0 {
+			if nonZero > math.MaxInt/length {
+				return nil, nil, errors.New("Shape has too many elements")
+			}
+
+			nonZero *= length
+		}
+
+		n *= length
+	}
+
+	if n == 0 {
+		outer := 1
+
+		for _, length := range lengths[:len(lengths)-1] {
+			if outer *= length; outer > ite.MaxEmptySlices {
+				return nil, nil, fmt.Errorf(
+					"Shape without elements has more than %d slices", ite.MaxEmptySlices)
+			}
+		}
+	}
+
+	flat, err := ite.ReadFlat(byteReader, conv, n)
+	return flat, lengths, err
+}
+
+// Splits elements into rows of cols elements sharing the same array
+func split2D[T any](flat []T, rows, cols int) [][]T {
+	res := make([][]T, rows)
+
+	for i := range res {
+		res[i] = flat[i*cols : (i+1)*cols : (i+1)*cols]
+	}
+
+	return res
+}
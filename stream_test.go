@@ -0,0 +1,685 @@
+package gonumberio_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	r "reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	nio "github.com/Matej-Chmel/go-number-io"
+)
+
+// Buffer that another goroutine appends to
+type growingBuffer struct {
+	buf bytes.Buffer
+	mu  sync.Mutex
+}
+
+func (g *growingBuffer) Read(p []byte) (int, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.buf.Read(p)
+}
+
+func (g *growingBuffer) append(s string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.buf.WriteString(s)
+}
+
+// Hides io.ReaderAt of the underlying reader
+type onlySeeker struct {
+	io.ReadSeeker
+}
+
+func TestReadTail(t *testing.T) {
+	input := "1 2\r\n3 4\r\n\r\n5 6\r\n  \r\n7\r\n\r\n\r\n"
+
+	for _, n := range []int{0, 1, 3, 10} {
+		all := [][]int{{1, 2}, {3, 4}, {5, 6}, {7}}
+		expected := all[max(len(all)-n, 0):]
+
+		for _, chunkSize := range []int{1, 3, nio.DefaultChunkSize} {
+			opts := nio.Options{ChunkSize: chunkSize}
+			conv := nio.GetConversion[int]()
+			seekers := []io.ReadSeeker{
+				strings.NewReader(input), onlySeeker{strings.NewReader(input)},
+			}
+
+			for _, seeker := range seekers {
+				actual, err := nio.ReadTail2DOptions(seeker, n, opts, conv)
+
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !r.DeepEqual(actual, expected) {
+					t.Errorf("n = %d, chunk size %d: %v != %v", n, chunkSize, actual, expected)
+				}
+			}
+		}
+	}
+
+	last, err := nio.ReadTail2D[int](strings.NewReader("1\n2\n3"), 2)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := [][]int{{2}, {3}}; !r.DeepEqual(last, expected) {
+		t.Errorf("%v != %v", last, expected)
+	}
+
+	for _, opts := range []nio.Options{
+		{BlockSeparator: '|'}, {PreserveEmpty: true}, {SkipLines: 1},
+	} {
+		if _, err = nio.ReadTail2DOptions(strings.NewReader(input), 2, opts,
+			nio.GetConversion[int]()); err == nil {
+			t.Errorf("Expected error for %+v", opts)
+		}
+	}
+}
+
+func TestIndexedMatrix(t *testing.T) {
+	file, err := openFile[int](3)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer file.Close()
+	index, err := nio.BuildRowIndex(file)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var saved strings.Builder
+
+	if _, err = index.WriteTo(&saved); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := nio.ReadRowIndex(strings.NewReader(saved.String()))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !r.DeepEqual(index, loaded) {
+		t.Errorf("%v != %v", index, loaded)
+	}
+
+	m := nio.NewIndexedMatrix[int](file, loaded)
+
+	if m.Blocks() != len(intD3) {
+		t.Fatalf("%d 2D slices != %d", m.Blocks(), len(intD3))
+	}
+
+	i := 0
+
+	for b, block := range intD3 {
+		actual, err := m.Block(b)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !r.DeepEqual(actual, block) {
+			t.Errorf("%v != %v", actual, block)
+		}
+
+		for _, expected := range block {
+			row, err := m.Row(i)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !r.DeepEqual(row, expected) {
+				t.Errorf("Row %d: %v != %v", i, row, expected)
+			}
+
+			i++
+		}
+	}
+
+	if _, err = m.Row(m.Rows()); err == nil {
+		t.Error("Expected error for a row out of range")
+	}
+
+	if _, err = nio.ReadRowIndex(strings.NewReader("1 2 3")); err == nil {
+		t.Error("Expected error for an input that is not a row index")
+	}
+
+	// Size, numbers of rows and 2D slices and deltas of row offsets
+	for _, values := range [][]uint64{
+		{1 << 63, 1, 0, 0},
+		{100, 1, 0, 1 << 63},
+		{100, 2, 0, 1 << 62, 1 << 62},
+		{100, 2, 0, 5, 0},
+		{100, 2, 2, 0, 5, 0, 0},
+	} {
+		data := []byte("NIOROWS1")
+
+		for _, val := range values {
+			data = binary.AppendUvarint(data, val)
+		}
+
+		if _, err = nio.ReadRowIndex(bytes.NewReader(data)); err == nil {
+			t.Errorf("Expected error for row index %v", values)
+		}
+	}
+
+	if _, err = nio.NewIndexedMatrixOptions(file, loaded,
+		nio.Options{RowSeparator: ';'}, nio.GetConversion[int]()); err == nil {
+		t.Error("Expected error for a row separator")
+	}
+}
+
+func TestRowReader(t *testing.T) {
+	opts := nio.Options{ChunkSize: 2, Sentinel: "END", SkipLines: 1}
+	reader, err := nio.NewRowReaderOptions(
+		strings.NewReader("header\n1 2\n\n\n3\n4 5 6\nEND\n7\n"), opts,
+		nio.GetConversion[int]())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := [][]int{{1, 2}, {3}, {4, 5, 6}}
+	blocks := []int{0, 1, 1}
+
+	for i := range expected {
+		row, err := reader.Next()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !r.DeepEqual(row, expected[i]) || reader.Block() != blocks[i] {
+			t.Errorf("%v in 2D slice %d != %v in 2D slice %d",
+				row, reader.Block(), expected[i], blocks[i])
+		}
+	}
+
+	if _, err = reader.Next(); err != io.EOF {
+		t.Errorf("Expected io.EOF at the sentinel, got %v", err)
+	}
+}
+
+func TestFollow(t *testing.T) {
+	input := &growingBuffer{}
+	input.append("1 2\n3 ")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reader, err := nio.NewFollowRowReader(
+		ctx, input, time.Millisecond, nio.Options{}, nio.GetConversion[int]())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		input.append("4\n\n5\n6 7")
+	}()
+
+	expected := [][]int{{1, 2}, {3, 4}, {5}}
+	blocks := []int{0, 0, 1}
+
+	for i := range expected {
+		row, err := reader.Next()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !r.DeepEqual(row, expected[i]) || reader.Block() != blocks[i] {
+			t.Errorf("%v in 2D slice %d != %v in 2D slice %d",
+				row, reader.Block(), expected[i], blocks[i])
+		}
+	}
+
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	if row, err := reader.Next(); err != context.Canceled {
+		t.Errorf("Expected context.Canceled instead of partial row %v, got %v", row, err)
+	}
+}
+
+func TestCheckpoint(t *testing.T) {
+	input := "1 2;\n3|\r\n\r\n4 5; 6\n\n7\r\n---\n8 9\n"
+	opts := nio.Options{
+		BlockMarker: "---", BlockSeparator: '|', ChunkSize: 3, RowSeparator: ';',
+	}
+	conv := nio.GetConversion[int]()
+	expected := [][]int{{1, 2}, {3}, {4, 5}, {6}, {7}, {8, 9}}
+	blocks := []int{0, 0, 1, 1, 2, 3}
+
+	for stop := 0; stop <= len(expected); stop++ {
+		reader, err := nio.NewRowReaderOptions(strings.NewReader(input), opts, conv)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < stop; i++ {
+			if _, err = reader.Next(); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		data, err := reader.Checkpoint().MarshalBinary()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var cp nio.Checkpoint
+
+		if err = cp.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+
+		if cp != reader.Checkpoint() {
+			t.Errorf("%v != %v", cp, reader.Checkpoint())
+		}
+
+		resumed, err := nio.ResumeRowReader(strings.NewReader(input), cp, opts, conv)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := stop; ; i++ {
+			row, err := resumed.Next()
+
+			if err == io.EOF {
+				if i != len(expected) {
+					t.Errorf("Resumed after %d rows: %d rows != %d", stop, i, len(expected))
+				}
+
+				break
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			if i >= len(expected) || !r.DeepEqual(row, expected[i]) ||
+				resumed.Block() != blocks[i] {
+				t.Errorf("Resumed after %d rows: row %d %v in 2D slice %d",
+					stop, i, row, resumed.Block())
+				break
+			}
+		}
+	}
+
+	var cp nio.Checkpoint
+
+	if err := cp.UnmarshalBinary([]byte("1 2 3")); err == nil {
+		t.Error("Expected error for data that is not a checkpoint")
+	}
+}
+
+func TestParallel(t *testing.T) {
+	var builder strings.Builder
+	builder.WriteString("skipped header\n")
+
+	for i := 0; i < 300; i++ {
+		for j := 0; j <= i%7; j++ {
+			fmt.Fprintf(&builder, "%d ", i*j-50)
+		}
+
+		if i%3 == 0 {
+			builder.WriteString(";")
+		}
+
+		if i%5 == 0 {
+			builder.WriteString("\r\n\r\n")
+		} else {
+			builder.WriteString("\n")
+		}
+	}
+
+	input := builder.String()
+	lines := strings.SplitAfter(input, "\n")
+	badLine := 150
+
+	for strings.TrimSpace(lines[badLine-1]) == "" {
+		badLine++
+	}
+
+	lines[badLine-1] = "7 8x 9\n"
+	bad := strings.Join(lines, "")
+	conv := nio.GetConversion[int]()
+
+	for _, chunkSize := range []int{5, nio.DefaultChunkSize} {
+		opts := nio.Options{
+			ChunkSize: chunkSize, Positions: true, RowSeparator: ';', SkipLines: 1,
+		}
+		expected, err := nio.Read2DOptions(strings.NewReader(input), opts, conv)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, expectedErr := nio.Read2DOptions(strings.NewReader(bad), opts, conv)
+		var posErr *nio.PositionError
+
+		if !errors.As(expectedErr, &posErr) || posErr.Line != badLine {
+			t.Fatalf("Expected error at line %d, got %v", badLine, expectedErr)
+		}
+
+		for _, workers := range []int{0, 2, 7, 32} {
+			actual, err := nio.Read2DParallelOptions(
+				strings.NewReader(input), int64(len(input)), workers, opts, conv)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !r.DeepEqual(actual, expected) {
+				t.Errorf("%d workers: results differ", workers)
+			}
+
+			_, err = nio.Read2DParallelOptions(
+				strings.NewReader(bad), int64(len(bad)), workers, opts, conv)
+
+			if err == nil || err.Error() != expectedErr.Error() {
+				t.Errorf("%d workers: %v != %v", workers, err, expectedErr)
+			}
+		}
+	}
+
+	// Without Positions, errors are returned as before
+	_, expectedErr := nio.Read2D[int](strings.NewReader(bad))
+	var posErr *nio.PositionError
+
+	if expectedErr == nil || errors.As(expectedErr, &posErr) {
+		t.Fatalf("Expected error without position, got %v", expectedErr)
+	}
+
+	_, err := nio.Read2DParallel[int](strings.NewReader(bad), int64(len(bad)))
+
+	if err == nil || err.Error() != expectedErr.Error() {
+		t.Errorf("%v != %v", err, expectedErr)
+	}
+}
+
+func TestMemory(t *testing.T) {
+	input := "1 2;3\r\n\r\n-4 5 6|7\n"
+	opts := nio.Options{BlockSeparator: '|', RowSeparator: ';'}
+	expected, err := nio.Read3DOptions(strings.NewReader(input), opts, nio.GetConversion[int]())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fromBytes, err := nio.ReadBytesOptions[[][][]int]([]byte(input), opts)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fromString, err := nio.ReadStringOptions[[][][]int](input, opts)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !r.DeepEqual(fromBytes, expected) || !r.DeepEqual(fromString, expected) {
+		t.Errorf("%v and %v != %v", fromBytes, fromString, expected)
+	}
+
+	_, expectedErr := nio.Read2D[float64](strings.NewReader("1.5 2\n3 4..5\n"))
+	_, err = nio.ReadString[[][]float64]("1.5 2\n3 4..5\n")
+
+	if err == nil || err.Error() != expectedErr.Error() {
+		t.Errorf("%v != %v", err, expectedErr)
+	}
+
+	data := []byte("ab cd\n ef")
+	tokens, err := nio.Read2DFrom(nio.NewByteReaderBytes(data), nio.Options{}, nio.ConvertBytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data[0] = 'x'
+
+	if string(tokens[0][0]) != "xb" || string(tokens[1][0]) != "ef" {
+		t.Errorf("Tokens %q are not views of the input", tokens)
+	}
+
+	tokens, err = nio.Read2DFrom(nio.NewByteReaderString("ab cd\n ef"), nio.Options{}, nio.ConvertBytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokens[0][0][0] = 'x'
+
+	if expected := [][][]byte{{[]byte("xb"), []byte("cd")}, {[]byte("ef")}}; !r.DeepEqual(tokens, expected) {
+		t.Errorf("%q != %q", tokens, expected)
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	file, err := openFile[int](2)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := nio.Read2D[int](file)
+	file.Close()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := nio.ReadFile[[][]int](file.Name())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !r.DeepEqual(actual, expected) {
+		t.Errorf("%v != %v", actual, expected)
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "tokens.txt")
+
+	if err = os.WriteFile(path, []byte("ab cd\nef 1.5x\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tokens, err := nio.ReadFileFrom(path, 0, func(r *nio.ByteReader) ([][]string, error) {
+		return nio.Read2DFrom(r, nio.Options{}, nio.ConvertString)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := [][]string{{"ab", "cd"}, {"ef", "1.5x"}}; !r.DeepEqual(tokens, expected) {
+		t.Errorf("%q != %q", tokens, expected)
+	}
+
+	bytesTokens, err := nio.ReadFileFrom(path, 0, func(r *nio.ByteReader) ([][][]byte, error) {
+		return nio.Read2DFrom(r, nio.Options{}, nio.ConvertBytes)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Tokens must be copies that stay valid after the file is unmapped
+	if string(bytesTokens[1][1]) != "1.5x" {
+		t.Errorf("%q != \"1.5x\"", bytesTokens[1][1])
+	}
+
+	_, expectedErr := nio.Read2D[float64](strings.NewReader("ab cd\nef 1.5x\n"))
+
+	if _, err = nio.ReadFile[[][]float64](path); err == nil || err.Error() != expectedErr.Error() {
+		t.Errorf("%v != %v", err, expectedErr)
+	}
+
+	empty := filepath.Join(dir, "empty.txt")
+
+	if err = os.WriteFile(empty, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if rows, err := nio.ReadFile[[][]int](empty); err != nil || len(rows) != 0 {
+		t.Errorf("Expected no rows, got %v, %v", rows, err)
+	}
+
+	if _, err = nio.ReadFile[[]int](filepath.Join(dir, "missing.txt")); err == nil {
+		t.Error("Expected error for a missing file")
+	}
+}
+
+// Reader that cancels a context when it is read for the first time
+type cancelReader struct {
+	cancel context.CancelFunc
+	io.Reader
+}
+
+func (c cancelReader) Read(b []byte) (int, error) {
+	c.cancel()
+	return c.Reader.Read(b)
+}
+
+func TestContext(t *testing.T) {
+	var builder strings.Builder
+
+	for i := 0; i < 5000; i++ {
+		fmt.Fprintf(&builder, "%d %d\n", i, -i)
+	}
+
+	input := builder.String()
+	expected, err := nio.Read2D[int](strings.NewReader(input))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conv := nio.GetConversion[int]()
+	opts := nio.Options{ChunkSize: 64}
+	actual, err := nio.Read2DContext(context.Background(), strings.NewReader(input), opts, conv)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !r.DeepEqual(actual, expected) {
+		t.Error("Results with a context differ")
+	}
+
+	for _, prefetch := range []bool{false, true} {
+		opts := nio.Options{ChunkSize: 64, Prefetch: prefetch}
+		ctx, cancel := context.WithCancel(context.Background())
+		_, err = nio.Read2DContext(ctx, cancelReader{cancel, strings.NewReader(input)}, opts, conv)
+		var posErr *nio.PositionError
+
+		if !errors.Is(err, context.Canceled) || !errors.As(err, &posErr) {
+			t.Fatalf("Prefetch %t: expected context.Canceled with position, got %v",
+				prefetch, err)
+		}
+
+		// The first chunk is parsed before the cancellation is noticed
+		if !prefetch &&
+			(posErr.Offset <= 0 || posErr.Offset >= int64(len(input)) || posErr.Line <= 1) {
+			t.Errorf("Unexpected position %d, line %d", posErr.Offset, posErr.Line)
+		}
+	}
+
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	_, err = nio.ReadContext[[][]int](expired, strings.NewReader(input), opts)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+
+	_, err = nio.ReadBytesContext[[]int](expired, []byte(input), opts)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded for bytes, got %v", err)
+	}
+
+	_, err = nio.ReadStringContext[int](expired, "1", opts)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded for a string, got %v", err)
+	}
+
+	_, err = nio.Read2DParallelContext(
+		expired, strings.NewReader(input), int64(len(input)), 4, opts, conv)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded for parallel reading, got %v", err)
+	}
+
+	_, err = nio.ReadRecords[int](nio.WithContext(expired, strings.NewReader("1 2\n")))
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded for records, got %v", err)
+	}
+
+	_, err = nio.ReadTail2DContext(expired, strings.NewReader(input), 3, opts, conv)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded for the last rows, got %v", err)
+	}
+
+	index, err := nio.BuildRowIndex(strings.NewReader(input))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := nio.NewIndexedMatrix[int](strings.NewReader(input), index)
+
+	if _, err = m.RowContext(expired, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded for a row, got %v", err)
+	}
+
+	if _, err = m.BlockContext(expired, 0); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded for a 2D slice, got %v", err)
+	}
+
+	resumed, err := nio.ResumeRowReaderContext(expired, strings.NewReader(input),
+		nio.Checkpoint{Line: 1, LineStart: true}, opts, conv)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = resumed.Next(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded for a resumed reader, got %v", err)
+	}
+
+	fromString, err := nio.ReadStringContext[[][]int](context.Background(), input, opts)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !r.DeepEqual(fromString, expected) {
+		t.Error("Results of a string with a context differ")
+	}
+}
@@ -0,0 +1,115 @@
+package gonumberio
+
+import (
+	"fmt"
+	"strings"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Bidirectional table of categorical symbols and their integer codes
+type SymbolTable[T constraints.Integer] struct {
+	codes       map[string]T
+	fallback    T
+	hasFallback bool
+	symbols     map[T]string
+}
+
+// Constructs new SymbolTable with codes 0, 1, 2, ... in the order of symbols
+func NewSymbolTable[T constraints.Integer](symbols ...string) (*SymbolTable[T], error) {
+	codes := make(map[string]T, len(symbols))
+
+	for i, symbol := range symbols {
+		if _, ok := codes[symbol]; ok {
+			return nil, fmt.Errorf("Duplicate symbol %q", symbol)
+		}
+
+		codes[symbol] = T(i)
+	}
+
+	return NewSymbolTableMap(codes)
+}
+
+// Constructs new SymbolTable from a map of symbols to codes.
+// Each code must belong to exactly one symbol.
+func NewSymbolTableMap[T constraints.Integer](codes map[string]T) (*SymbolTable[T], error) {
+	res := &SymbolTable[T]{
+		codes:       make(map[string]T, len(codes)),
+		fallback:    0,
+		hasFallback: false,
+		symbols:     make(map[T]string, len(codes)),
+	}
+
+	for symbol, code := range codes {
+		if symbol == "" || strings.ContainsAny(symbol, " \t\r\n") {
+			return nil, fmt.Errorf("Symbol %q is empty or contains whitespace", symbol)
+		}
+
+		if other, ok := res.symbols[code]; ok {
+			return nil, fmt.Errorf(
+				"Symbols %q and %q have the same code %d", other, symbol, code)
+		}
+
+		res.codes[symbol] = code
+		res.symbols[code] = symbol
+	}
+
+	return res, nil
+}
+
+// Returns code of symbol
+func (t *SymbolTable[T]) Code(symbol string) (T, bool) {
+	code, ok := t.codes[symbol]
+	return code, ok
+}
+
+// Returns conversion function that reads whitespace separated symbols as codes.
+// Unknown symbols are an error unless a fallback code is set.
+func (t *SymbolTable[T]) Conversion() func(*ByteReader) (T, uint, error) {
+	return func(r *ByteReader) (T, uint, error) {
+		var arr [16]byte
+		token, flags, err := r.NextToken(arr[:0])
+
+		if (flags & ite.HasValue) == 0 {
+			return 0, flags, err
+		}
+
+		if code, ok := t.codes[string(token)]; ok {
+			return code, flags, err
+		}
+
+		if t.hasFallback {
+			return t.fallback, flags, err
+		}
+
+		return 0, 0, fmt.Errorf("Unknown symbol %q", token)
+	}
+}
+
+// Format function that writes codes as their symbols
+func (t *SymbolTable[T]) Format(buf []byte, val T) ([]byte, error) {
+	symbol, ok := t.symbols[val]
+
+	if !ok {
+		return buf, fmt.Errorf("Code %d has no symbol", val)
+	}
+
+	return append(buf, symbol...), nil
+}
+
+// Returns symbol of code
+func (t *SymbolTable[T]) Symbol(code T) (string, bool) {
+	symbol, ok := t.symbols[code]
+	return symbol, ok
+}
+
+// Returns copy of the table that reads unknown symbols as code
+// instead of returning an error. The original table is not changed.
+func (t *SymbolTable[T]) WithFallback(code T) *SymbolTable[T] {
+	res := *t
+	res.fallback = code
+	res.hasFallback = true
+	return &res
+}
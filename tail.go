@@ -0,0 +1,68 @@
+package gonumberio
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+// Read the last n rows of a 2D slice of type T from a seekable input.
+// Only the last n lines that are not empty are parsed.
+func ReadTail2D[T any](r io.ReadSeeker, n int) ([][]T, error) {
+	return ReadTail2DOptions(r, n, Options{}, GetConversion[T]())
+}
+
+// Read the last n rows of a 2D slice of type T from a seekable input with options.
+// Rows are counted as lines that are not empty, so separators,
+// PreserveEmpty and SkipLines from options are not supported.
+func ReadTail2DOptions[T any](
+	r io.ReadSeeker, n int, opts Options,
+	conv func(*ByteReader) (T, uint, error)) ([][]T, error) {
+
+	return ReadTail2DContext(context.Background(), r, n, opts, conv)
+}
+
+// Read the last n rows of a 2D slice of type T from a seekable input
+// with options like ReadTail2DOptions until ctx is done.
+// The error of ctx is returned in PositionError only while rows are parsed.
+func ReadTail2DContext[T any](
+	ctx context.Context, r io.ReadSeeker, n int, opts Options,
+	conv func(*ByteReader) (T, uint, error)) ([][]T, error) {
+
+	if err := checkNoSeparators(opts); err != nil {
+		return nil, err
+	}
+
+	if opts.PreserveEmpty || opts.SkipLines > 0 {
+		return nil, errors.New(
+			"PreserveEmpty and SkipLines are not supported when reading the last rows")
+	}
+
+	size, err := r.Seek(0, io.SeekEnd)
+
+	if err != nil {
+		return nil, err
+	}
+
+	readerAt := ite.AsReaderAt(r)
+	offset, err := ite.FindTailOffset(
+		ite.NewContextReaderAt(ctx, readerAt), size, n, opts.ChunkSize)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Read2DContext(ctx, io.NewSectionReader(readerAt, offset, size-offset), opts, conv)
+}
+
+// Returns error if options contain separators,
+// which are not supported when rows are located by newlines
+func checkNoSeparators(opts Options) error {
+	if opts.RowSeparator != 0 || opts.BlockSeparator != 0 || opts.BlockMarker != "" {
+		return errors.New("Separators are not supported when rows are located by newlines")
+	}
+
+	return nil
+}
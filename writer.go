@@ -0,0 +1,50 @@
+package gonumberio
+
+import (
+	"io"
+
+	ite "github.com/Matej-Chmel/go-number-io/internal"
+)
+
+// Write a 1D slice of type T to a Writer as one line
+func Write1D[T any](w io.Writer, data []T) error {
+	return Write1DCustom(w, DefaultChunkSize, data, GetFormat[T]())
+}
+
+// Write a 1D slice of type T to a Writer as one line with options
+func Write1DCustom[T any](
+	w io.Writer, chunkSize int, data []T,
+	format func([]byte, T) ([]byte, error)) error {
+
+	return ite.RunSliceWriter(w, chunkSize, format,
+		func(s *ite.SliceWriter[T]) error { return s.Write1D(data) })
+}
+
+// Write a 2D slice of type T to a Writer, one row per line
+func Write2D[T any](w io.Writer, data [][]T) error {
+	return Write2DCustom(w, DefaultChunkSize, data, GetFormat[T]())
+}
+
+// Write a 2D slice of type T to a Writer, one row per line with options
+func Write2DCustom[T any](
+	w io.Writer, chunkSize int, data [][]T,
+	format func([]byte, T) ([]byte, error)) error {
+
+	return ite.RunSliceWriter(w, chunkSize, format,
+		func(s *ite.SliceWriter[T]) error { return s.Write2D(data) })
+}
+
+// Write a 3D slice of type T to a Writer, 2D slices separated by empty lines
+func Write3D[T any](w io.Writer, data [][][]T) error {
+	return Write3DCustom(w, DefaultChunkSize, data, GetFormat[T]())
+}
+
+// Write a 3D slice of type T to a Writer,
+// 2D slices separated by empty lines with options
+func Write3DCustom[T any](
+	w io.Writer, chunkSize int, data [][][]T,
+	format func([]byte, T) ([]byte, error)) error {
+
+	return ite.RunSliceWriter(w, chunkSize, format,
+		func(s *ite.SliceWriter[T]) error { return s.Write3D(data) })
+}
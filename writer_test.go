@@ -0,0 +1,64 @@
+package gonumberio_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	nio "github.com/Matej-Chmel/go-number-io"
+)
+
+func checkWritten(t *testing.T, write func(*strings.Builder) error, expected string) {
+	var sb strings.Builder
+
+	if err := write(&sb); err != nil {
+		t.Error(err)
+	} else if actual := sb.String(); actual != expected {
+		t.Errorf("\n\n%q\n\n!=\n\n%q", actual, expected)
+	}
+}
+
+func TestWriteDecimal(t *testing.T) {
+	data := [][]nio.Decimal{
+		{{Mantissa: 1999, Scale: 2}, {Mantissa: -5, Scale: 2}},
+		{{Mantissa: 7, Scale: 0}, {Mantissa: -9223372036854775808, Scale: 18}},
+	}
+
+	checkWritten(t, func(sb *strings.Builder) error {
+		return nio.Write2D(sb, data)
+	}, "19.99 -0.05\n7 -9.223372036854775808\n")
+}
+
+func TestWriteRoundTrip(t *testing.T) {
+	checkWritten(t, func(sb *strings.Builder) error {
+		return nio.Write3D(sb, intD3)
+	}, "-1 2 3\n0 0 0\n\n-1 -2 -3\n-4 -5 -6\n7 8 9\n\n0\n\n"+
+		"100 10000\n-20132 -2121\n-3000 10300 12001 14001\n9091 8091 17003\n90123\n")
+
+	var sb strings.Builder
+
+	if err := nio.Write2D(&sb, floatD2); err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := nio.Read2D[float32](strings.NewReader(sb.String()))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !compare2D(actual, floatD2, equals[float32]) {
+		t.Errorf("%v != %v", actual, floatD2)
+	}
+}
+
+func TestWriteComplex(t *testing.T) {
+	inf := math.Inf(1)
+	data := []complex128{
+		complex(1.5, 2), complex(-3, -0.25), complex(0, inf), complex(0, -inf),
+	}
+
+	checkWritten(t, func(sb *strings.Builder) error {
+		return nio.Write1D(sb, data)
+	}, "1.5+2i -3-0.25i 0+Infi 0-Infi\n")
+}